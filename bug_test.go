@@ -2,34 +2,49 @@ package bug
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"regexp"
+	"runtime/debug"
 	"strconv"
 	"testing"
+	"time"
 
 	"entgo.io/bug/ent"
 	"entgo.io/bug/ent/enttest"
+	"entgo.io/bug/ent/event"
+	"entgo.io/bug/ent/hook"
+	"entgo.io/bug/ent/migrate"
+	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/purgelease"
 	_ "entgo.io/bug/ent/runtime"
 	"entgo.io/bug/ent/schema"
+	"entgo.io/bug/ent/session"
+	"entgo.io/bug/ent/todo"
+	"entgo.io/bug/ent/user"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	sqlschema "entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/privacy"
+	"entgo.io/ent/schema/index"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 func TestBugSQLite(t *testing.T) {
-	client := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
-	defer client.Close()
-	test(t, client)
+	test(t, database{dialect: dialect.SQLite, dsn: "file:ent?mode=memory&cache=shared&_fk=1", live: true})
 }
 
 func TestBugMySQL(t *testing.T) {
 	for version, port := range map[string]int{"56": 3306, "57": 3307, "8": 3308} {
 		addr := net.JoinHostPort("localhost", strconv.Itoa(port))
 		t.Run(version, func(t *testing.T) {
-			client := enttest.Open(t, dialect.MySQL, fmt.Sprintf("root:pass@tcp(%s)/test?parseTime=True", addr))
-			defer client.Close()
-			test(t, client)
+			// Live indexes need indexed generated columns, added in MySQL 5.7.
+			test(t, database{dialect: dialect.MySQL, dsn: fmt.Sprintf("root:pass@tcp(%s)/test?parseTime=True", addr), live: version != "56"})
 		})
 	}
 }
@@ -37,9 +52,7 @@ func TestBugMySQL(t *testing.T) {
 func TestBugPostgres(t *testing.T) {
 	for version, port := range map[string]int{"10": 5430, "11": 5431, "12": 5432, "13": 5433, "14": 5434} {
 		t.Run(version, func(t *testing.T) {
-			client := enttest.Open(t, dialect.Postgres, fmt.Sprintf("host=localhost port=%d user=postgres dbname=test password=pass sslmode=disable", port))
-			defer client.Close()
-			test(t, client)
+			test(t, database{dialect: dialect.Postgres, dsn: fmt.Sprintf("host=localhost port=%d user=postgres dbname=test password=pass sslmode=disable", port), live: true})
 		})
 	}
 }
@@ -48,26 +61,70 @@ func TestBugMaria(t *testing.T) {
 	for version, port := range map[string]int{"10.5": 4306, "10.2": 4307, "10.3": 4308} {
 		t.Run(version, func(t *testing.T) {
 			addr := net.JoinHostPort("localhost", strconv.Itoa(port))
-			client := enttest.Open(t, dialect.MySQL, fmt.Sprintf("root:pass@tcp(%s)/test?parseTime=True", addr))
-			defer client.Close()
-			test(t, client)
+			test(t, database{dialect: dialect.MySQL, dsn: fmt.Sprintf("root:pass@tcp(%s)/test?parseTime=True", addr), live: true})
 		})
 	}
 }
 
-func test(t *testing.T, client *ent.Client) {
+// The query template of the soft delete is a copy of the upstream one, and must be
+// synced with it when ent is upgraded.
+func TestQueryTemplateVersion(t *testing.T) {
+	tmpl, err := os.ReadFile("ent/schema/query.tmpl")
+	if err != nil {
+		t.Fatalf("could not read the query template: %v", err)
+	}
+	m := regexp.MustCompile(`builder/query\.tmpl of entgo\.io/ent (\S+),`).FindSubmatch(tmpl)
+	if m == nil {
+		t.Fatal("expected the query template to record the ent version it was copied from")
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		t.Fatal("could not read the build info")
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "entgo.io/ent" && dep.Version != string(m[1]) {
+			t.Errorf("the query template was copied from ent %s, but the module requires %s: sync it with upstream", m[1], dep.Version)
+		}
+	}
+}
+
+// database is the database of a test.
+type database struct {
+	dialect, dsn string
+	// live reports if the database supports the live indexes.
+	live bool
+}
+
+// open opens a client on the database, closed when the test ends. If the database
+// supports them, the unique indexes of the soft-deletable tables are created over
+// their live rows. Tests that install hooks, callbacks or policies do it on a client
+// of their own, so the shared one keeps its configuration.
+func (db database) open(t *testing.T, o ...ent.Option) *ent.Client {
+	var opts []sqlschema.MigrateOption
+	if db.live {
+		opts = append(opts, migrate.WithLiveIndexes())
+	}
+	client := enttest.Open(t, db.dialect, db.dsn, enttest.WithMigrateOptions(opts...), enttest.WithOptions(o...))
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func test(t *testing.T, db database) {
+	client := db.open(t)
 	client = client.Debug()
 	ctx := context.Background()
 
 	// driver.Query: query=INSERT INTO `users` (`age`, `name`) VALUES (?, ?) RETURNING `id` args=[30 Ariel]
 	u := client.User.Create().SetName("Ariel").SetAge(30).SaveX(ctx)
 
-	// driver.Query: query=SELECT COUNT(DISTINCT `users`.`id`) FROM `users` args=[]
+	// driver.Query: query=SELECT COUNT(DISTINCT `users`.`id`) FROM `users` WHERE `users`.`deleted_time` IS NULL args=[]
 	if n := client.User.Query().CountX(ctx); n != 1 {
 		t.Errorf("unexpected number of users: %d", n)
 	}
 
-	// driver.Exec: query=UPDATE `users` SET `deleted_time` = ? WHERE `users`.`id` IN (?) args=[2022-08-13 20:36:57.943277 -0300 -03 m=+0.007749764 1]
+	// Tx.Query: query=SELECT `users`.`id` FROM `users` WHERE `users`.`deleted_time` IS NULL AND `users`.`id` = ? args=[1]
+	// Tx.Query: query=SELECT `todos`.`id` FROM `todos` JOIN (SELECT `users`.`id` FROM `users` WHERE `users`.`deleted_time` IS NULL AND `users`.`id` IN (?)) AS `t1` ON `todos`.`user_todos` = `t1`.`id` WHERE `todos`.`deleted_time` IS NULL args=[1]
+	// Tx.Exec: query=UPDATE `users` SET `deleted_time` = ?, `deleted_batch` = ? WHERE `users`.`id` IN (?) args=[2022-08-13 20:36:57.943277 -0300 -03 m=+0.007749764 c2914c8f-5c49-4a75-9e2a-e11078fcd52e 1]
 	client.User.DeleteOne(u).ExecX(ctx)
 
 	// do a real delete op
@@ -84,7 +141,1330 @@ func test(t *testing.T, client *ent.Client) {
 	client.User.Create().SetName("Pedro").SetAge(28).SaveX(ctx)
 
 	// Delete many
-	// query=SELECT `users`.`id` FROM `users` args=[]
-	// query=UPDATE `users` SET `deleted_time` = ? WHERE `users`.`id` IN (?, ?) args=[2022-08-13 20:42:34.613814 -0300 -03 m=+0.009210090 2 3]
+	// query=SELECT `users`.`id` FROM `users` WHERE `users`.`deleted_time` IS NULL args=[]
+	// query=UPDATE `users` SET `deleted_time` = ?, `deleted_batch` = ? WHERE `users`.`id` IN (?, ?) args=[2022-08-13 20:42:34.613814 -0300 -03 m=+0.009210090 ba1da97b-fad2-41fb-920c-1d6e2a609297 2 3]
+	client.User.Delete().ExecX(ctx)
+
+	testQueryFilter(t, client)
+	testQueryDeleted(t, client)
+	testRestore(t, client)
+	testDeleteAffected(t, client)
+	testDeleteRestamp(t, client)
+	testSetDeletedTime(t, client)
+	testSoftDeleteDispatch(t, client, db)
+	testSoftDeleteUUID(t, client)
+	testSoftDeleteStrategies(t, client)
+	testDeletionInfo(t, client, db)
+	testSoftDeleteCascade(t, client)
+	testSoftDeleteActions(t, client)
+	testSoftDeleteMany(t, db)
+	testRestoreEdgePredicates(t, client, db)
+	testRestoreBatch(t, client)
+	testPurge(t, client)
+	testPurgeExpired(t, client)
+	testPurgeWorker(t, client, db)
+	testLiveIndexes(t, client, db)
+	testDeletedTimeIndexes(t)
+	testEdgeFilter(t, client)
+	testUpdateDeleted(t, client)
+	testDeletedErrors(t, client)
+	testLifecycle(t, client, db)
+	testDeleteKind(t, client, db)
+	testHardDelete(t, client, db)
+	testBypassPolicy(t, client, db)
+}
+
+// reset hard-deletes all rows of the soft-deletable types.
+func reset(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	if _, err := client.User.HardDelete().Exec(ctx); err != nil {
+		t.Fatalf("could not reset the users: %v", err)
+	}
+	if _, err := client.Todo.HardDelete().Exec(ctx); err != nil {
+		t.Fatalf("could not reset the todos: %v", err)
+	}
+	if _, err := client.Session.HardDelete().Exec(ctx); err != nil {
+		t.Fatalf("could not reset the sessions: %v", err)
+	}
+	if _, err := client.Tag.HardDelete().Exec(ctx); err != nil {
+		t.Fatalf("could not reset the tags: %v", err)
+	}
+	if _, err := client.Event.HardDelete().Exec(ctx); err != nil {
+		t.Fatalf("could not reset the events: %v", err)
+	}
+}
+
+func testQueryFilter(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Todo.Create().SetName("todo").SaveX(ctx)
+	client.User.DeleteOne(nati).ExecX(ctx)
+
+	// query=SELECT DISTINCT `users`.`id`, `users`.`deleted_time`, `users`.`deleted_by`, `users`.`deleted_reason`, `users`.`age`, `users`.`name` FROM `users` WHERE `users`.`deleted_time` IS NULL args=[]
+	if users := client.User.Query().AllX(ctx); len(users) != 1 || users[0].ID != a8m.ID {
+		t.Errorf("unexpected users: %v", users)
+	}
+	if n := client.User.Query().CountX(ctx); n != 1 {
+		t.Errorf("unexpected number of users: %d", n)
+	}
+	if client.User.Query().Where(user.Name("nati")).ExistX(ctx) {
+		t.Error("soft-deleted user should not exist")
+	}
+	if ids := client.User.Query().IDsX(ctx); len(ids) != 1 || ids[0] != a8m.ID {
+		t.Errorf("unexpected user ids: %v", ids)
+	}
+	if _, err := client.User.Get(ctx, nati.ID); !ent.IsDeleted(err) || !ent.IsNotFound(err) {
+		t.Errorf("expected deleted error for soft-deleted user, got: %v", err)
+	}
+	if _, err := client.User.Query().Where(user.Name("nati")).OnlyID(ctx); !ent.IsDeleted(err) {
+		t.Errorf("expected deleted error for soft-deleted user, got: %v", err)
+	}
+	if _, err := client.User.Query().Where(user.Name("unknown")).Only(ctx); !ent.IsNotFound(err) || ent.IsDeleted(err) {
+		t.Errorf("expected not found error for unknown user, got: %v", err)
+	}
+	var v []struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+	client.User.Query().GroupBy(user.FieldName).Aggregate(ent.Count()).ScanX(ctx, &v)
+	if len(v) != 1 || v[0].Name != "a8m" {
+		t.Errorf("unexpected groups: %v", v)
+	}
+
+	// Types without the mixin are not filtered.
+	client.Other.Create().SetName("other").SaveX(ctx)
+	if n := client.Other.Query().CountX(ctx); n == 0 {
+		t.Error("expected other rows to be returned")
+	}
+	client.Other.Delete().ExecX(ctx)
+
+	// Deleted todos are filtered as well.
+	client.Todo.Delete().ExecX(ctx)
+	if n := client.Todo.Query().CountX(ctx); n != 0 {
+		t.Errorf("unexpected number of todos: %d", n)
+	}
+}
+
+func testQueryDeleted(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.DeleteOne(nati).ExecX(ctx)
+
+	all := schema.WithIncludeDeleted(ctx)
+	if n := client.User.Query().CountX(all); n != 2 {
+		t.Errorf("unexpected number of users including deleted: %d", n)
+	}
+	if !client.User.Query().Where(user.Name("nati")).ExistX(all) {
+		t.Error("expected soft-deleted user to exist when including deleted")
+	}
+	if u := client.User.Query().Where(user.Name("nati")).OnlyX(all); u.DeletedTime.IsZero() {
+		t.Errorf("expected deleted time to be set: %v", u)
+	}
+
+	trash := schema.WithOnlyDeleted(ctx)
+	if n := client.User.Query().CountX(trash); n != 1 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+	if u := client.User.Query().FirstX(trash); u.ID != nati.ID {
+		t.Errorf("unexpected deleted user: %v", u)
+	}
+	if u := client.User.GetX(trash, nati.ID); u.Name != "nati" {
+		t.Errorf("unexpected deleted user: %v", u)
+	}
+	if client.User.Query().Where(user.ID(a8m.ID)).ExistX(trash) {
+		t.Error("live user should not be returned when querying only deleted")
+	}
+
+	// Viewing deleted rows does not turn deletes into hard deletes.
+	client.User.DeleteOne(a8m).ExecX(all)
+	if n := client.User.Query().CountX(trash); n != 2 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+}
+
+func testRestore(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	ariel := client.User.Create().SetName("ariel").SetAge(32).SaveX(ctx)
 	client.User.Delete().ExecX(ctx)
+
+	client.User.RestoreOne(a8m).ExecX(ctx)
+	if u := client.User.GetX(ctx, a8m.ID); !u.DeletedTime.IsZero() {
+		t.Errorf("expected deleted time to be cleared: %v", u)
+	}
+	// Live rows are not restored again.
+	if err := client.User.RestoreOneID(a8m.ID).Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("expected not found error for live user, got: %v", err)
+	}
+
+	if n := client.User.Restore().Where(user.AgeLT(30)).ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of restored users: %d", n)
+	}
+	if !client.User.Query().Where(user.ID(nati.ID)).ExistX(ctx) {
+		t.Error("expected restored user to exist")
+	}
+	if client.User.Query().Where(user.ID(ariel.ID)).ExistX(ctx) {
+		t.Error("expected user to remain deleted")
+	}
+	if n := client.User.Restore().ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of restored users: %d", n)
+	}
+	if n := client.User.Query().CountX(ctx); n != 3 {
+		t.Errorf("unexpected number of users: %d", n)
+	}
+}
+
+func testDeleteAffected(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("ariel").SetAge(32).SaveX(ctx)
+
+	if err := client.User.DeleteOne(a8m).Exec(ctx); err != nil {
+		t.Errorf("could not soft delete the user: %v", err)
+	}
+	// Deleting an already deleted user reports it as not found.
+	if err := client.User.DeleteOne(a8m).Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("expected not found error for deleted user, got: %v", err)
+	}
+	if err := client.User.DeleteOneID(a8m.ID + 100).Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("expected not found error for missing user, got: %v", err)
+	}
+	// query=SELECT `users`.`id` FROM `users` WHERE `users`.`deleted_time` IS NULL AND `users`.`age` > ? args=[29]
+	// query=UPDATE `users` SET `deleted_time` = ?, `deleted_batch` = ? WHERE `users`.`id` IN (?) args=[2022-08-13 20:42:34.613814 -0300 -03 m=+0.009210090 bf2a30dc-9b23-4821-a768-17e7ef439eae 9]
+	if n := client.User.Delete().Where(user.AgeGT(29)).ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+	n, err := client.User.Delete().Exec(ctx)
+	if err != nil {
+		t.Errorf("could not soft delete the users: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+	if n := client.User.Delete().ExecX(ctx); n != 0 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+	// Hard deletes report the removed rows.
+	if n := client.User.Delete().ExecX(schema.WithSkipDeletedTimeHook(ctx)); n != 3 {
+		t.Errorf("unexpected number of removed users: %d", n)
+	}
+}
+
+func testDeleteRestamp(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	deleted := client.User.GetX(schema.WithOnlyDeleted(ctx), a8m.ID).DeletedTime
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+
+	// Only the live user is stamped, the deleted time of a8m is kept.
+	if n := client.User.Delete().ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+	n, err := client.User.SetDeletedTime(ctx, deleted.Add(time.Hour), a8m.ID)
+	if err != nil || n != 0 {
+		t.Errorf("unexpected result of stamping a deleted user: %d, %v", n, err)
+	}
+	if u := client.User.GetX(schema.WithOnlyDeleted(ctx), a8m.ID); !u.DeletedTime.Equal(deleted) {
+		t.Errorf("deleted time was overwritten: %v != %v", u.DeletedTime, deleted)
+	}
+
+	// Restamping must be requested explicitly.
+	if n := client.User.Delete().ExecX(schema.WithRestampDeletedTime(ctx)); n != 2 {
+		t.Errorf("unexpected number of restamped users: %d", n)
+	}
+	n, err = client.User.SetDeletedTime(schema.WithRestampDeletedTime(ctx), deleted.Add(time.Hour), a8m.ID)
+	if err != nil || n != 1 {
+		t.Errorf("unexpected result of restamping a deleted user: %d, %v", n, err)
+	}
+}
+
+func testSetDeletedTime(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+	at := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	// Stamping runs the edge actions of the soft delete.
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	s := client.Session.Create().SetToken("secret").SaveX(ctx)
+	a8m.Update().AddSessions(s).ExecX(ctx)
+	if _, err := client.User.SetDeletedTime(ctx, at, a8m.ID); !ent.IsRestricted(err) {
+		t.Fatalf("expected a restricted error: %v", err)
+	}
+	client.Session.DeleteOne(s).ExecX(ctx)
+
+	// The owned todos are stamped with the same time.
+	td := client.Todo.Create().SetName("todo").SetOwner(a8m).SaveX(ctx)
+	if n, err := client.User.SetDeletedTime(ctx, at, a8m.ID); err != nil || n != 1 {
+		t.Fatalf("unexpected result of stamping a user: %d, %v", n, err)
+	}
+	if u := client.User.GetX(all, a8m.ID); !u.DeletedTime.Equal(at) {
+		t.Errorf("unexpected deleted time of the user: %v != %v", u.DeletedTime, at)
+	}
+	if td := client.Todo.GetX(all, td.ID); !td.DeletedTime.Equal(at) {
+		t.Errorf("expected the todo to be stamped with the user: %v != %v", td.DeletedTime, at)
+	}
+}
+
+func testSoftDeleteDispatch(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+
+	client.Todo.Create().SetName("todo").SaveX(ctx)
+	if n := client.Todo.Delete().ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of deleted todos: %d", n)
+	}
+	if n := client.Todo.Query().CountX(schema.WithOnlyDeleted(ctx)); n != 1 {
+		t.Errorf("unexpected number of soft-deleted todos: %d", n)
+	}
+
+	// The typed mutators force the soft delete of their mutations, even in hard-delete contexts.
+	client = db.open(t)
+	client.Todo.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoSoftDelete(next)
+	})
+	client.Todo.Create().SetName("kept").SaveX(ctx)
+	if n := client.Todo.Delete().Where(todo.Name("kept")).ExecX(schema.WithSkipDeletedTimeHook(ctx)); n != 1 {
+		t.Errorf("unexpected number of deleted todos: %d", n)
+	}
+	if n := client.Todo.Query().CountX(schema.WithOnlyDeleted(ctx)); n != 2 {
+		t.Errorf("unexpected number of soft-deleted todos: %d", n)
+	}
+}
+
+func testSoftDeleteUUID(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	s1 := client.Session.Create().SetToken("s1").SaveX(ctx)
+	s2 := client.Session.Create().SetToken("s2").SaveX(ctx)
+	if err := client.Session.DeleteOne(s1).Exec(ctx); err != nil {
+		t.Errorf("could not soft delete the session: %v", err)
+	}
+	if ids := client.Session.Query().IDsX(ctx); len(ids) != 1 || ids[0] != s2.ID {
+		t.Errorf("unexpected session ids: %v", ids)
+	}
+	if n := client.Session.Query().CountX(schema.WithOnlyDeleted(ctx)); n != 1 {
+		t.Errorf("unexpected number of deleted sessions: %d", n)
+	}
+
+	n, err := client.Session.SetDeletedTime(ctx, time.Now(), s2.ID)
+	if err != nil || n != 1 {
+		t.Errorf("unexpected result of stamping a session: %d, %v", n, err)
+	}
+	if _, err := ent.RestoreBatchForType(ctx, client, ent.TypeSession, 1); !ent.IsInvalidID(err) {
+		t.Errorf("expected restoring a session by an int id to fail: %v", err)
+	}
+
+	client.Session.RestoreOneID(s1.ID).ExecX(ctx)
+	if n := client.Session.Query().CountX(ctx); n != 1 {
+		t.Errorf("unexpected number of sessions: %d", n)
+	}
+}
+
+func testSoftDeleteStrategies(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	trash := schema.WithOnlyDeleted(ctx)
+
+	// Boolean flag.
+	tg := client.Tag.Create().SetName("go").SaveX(ctx)
+	client.Tag.Create().SetName("ent").SaveX(ctx)
+	client.Tag.DeleteOne(tg).ExecX(ctx)
+	if tg := client.Tag.GetX(trash, tg.ID); !tg.IsDeleted {
+		t.Errorf("expected tag to be flagged as deleted: %v", tg)
+	}
+	if n := client.Tag.Query().CountX(ctx); n != 1 {
+		t.Errorf("unexpected number of tags: %d", n)
+	}
+	client.Tag.RestoreOne(tg).ExecX(ctx)
+	if tg := client.Tag.GetX(ctx, tg.ID); tg.IsDeleted {
+		t.Errorf("expected tag flag to be cleared: %v", tg)
+	}
+
+	// Unix epoch integer.
+	ev := client.Event.Create().SetName("signup").SaveX(ctx)
+	client.Event.Create().SetName("login").SaveX(ctx)
+	if n := client.Event.Delete().Where(event.Name("signup")).ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of deleted events: %d", n)
+	}
+	if ev := client.Event.GetX(trash, ev.ID); ev.DeletedAt == 0 {
+		t.Errorf("expected event deletion epoch to be set: %v", ev)
+	}
+	if n := client.Event.Query().CountX(ctx); n != 1 {
+		t.Errorf("unexpected number of events: %d", n)
+	}
+	client.Event.RestoreOne(ev).ExecX(ctx)
+	if ev := client.Event.GetX(ctx, ev.ID); ev.DeletedAt != 0 {
+		t.Errorf("expected event deletion epoch to be reset: %v", ev)
+	}
+
+	// Non-null sentinel timestamp.
+	sentinel := session.DefaultDeletedAt()
+	s := client.Session.Create().SetToken("s").SaveX(ctx)
+	if !s.DeletedAt.Equal(sentinel) {
+		t.Errorf("expected sentinel deleted time on live session: %v", s)
+	}
+	client.Session.DeleteOne(s).ExecX(ctx)
+	if s := client.Session.GetX(trash, s.ID); s.DeletedAt.Equal(sentinel) {
+		t.Errorf("expected session deleted time to be set: %v", s)
+	}
+	if n := client.Session.Query().CountX(ctx); n != 0 {
+		t.Errorf("unexpected number of sessions: %d", n)
+	}
+	client.Session.RestoreOne(s).ExecX(ctx)
+	if s := client.Session.GetX(ctx, s.ID); !s.DeletedAt.Equal(sentinel) {
+		t.Errorf("expected sentinel deleted time on restored session: %v", s)
+	}
+}
+
+type actorKey struct{}
+
+func testDeletionInfo(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	trash := schema.WithOnlyDeleted(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	ariel := client.User.Create().SetName("ariel").SetAge(32).SaveX(ctx)
+
+	// query=UPDATE `users` SET `deleted_time` = ?, `deleted_batch` = ?, `deleted_by` = ?, `deleted_reason` = ? WHERE `users`.`id` IN (?) args=[2022-08-13 20:42:34.613814 -0300 -03 m=+0.009210090 a955ee38-89e0-42ab-9337-3d96c7ce4732 admin gdpr request 1]
+	client.User.DeleteOne(a8m).ExecX(schema.WithDeletedReason(schema.WithDeletedBy(ctx, "admin"), "gdpr request"))
+	if u := client.User.GetX(trash, a8m.ID); u.DeletedBy != "admin" || u.DeletedReason != "gdpr request" {
+		t.Errorf("unexpected deletion info: %q, %q", u.DeletedBy, u.DeletedReason)
+	}
+
+	n, err := client.User.SetDeletedTime(schema.WithDeletedBy(ctx, "cron"), time.Now(), nati.ID)
+	if err != nil || n != 1 {
+		t.Errorf("unexpected result of stamping a user: %d, %v", n, err)
+	}
+	if u := client.User.GetX(trash, nati.ID); u.DeletedBy != "cron" || u.DeletedReason != "" {
+		t.Errorf("unexpected deletion info: %q, %q", u.DeletedBy, u.DeletedReason)
+	}
+
+	// The actor is resolved when the context does not carry one.
+	resolver := schema.DeletedTime{
+		Audit: true,
+		DeletedBy: func(ctx context.Context) (string, error) {
+			actor, _ := ctx.Value(actorKey{}).(string)
+			return actor, nil
+		},
+	}
+	resolved := db.open(t)
+	resolved.User.Use(resolver.Hooks()...)
+	resolved.User.DeleteOneID(ariel.ID).ExecX(context.WithValue(ctx, actorKey{}, "viewer"))
+	if u := client.User.GetX(trash, ariel.ID); u.DeletedBy != "viewer" {
+		t.Errorf("unexpected deletion actor: %q", u.DeletedBy)
+	}
+
+	client.User.Restore().ExecX(ctx)
+	if u := client.User.GetX(ctx, a8m.ID); u.DeletedBy != "" || u.DeletedReason != "" {
+		t.Errorf("expected deletion info to be cleared: %q, %q", u.DeletedBy, u.DeletedReason)
+	}
+}
+
+func testSoftDeleteCascade(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	parent := client.Todo.Create().SetName("parent").SetOwner(a8m).SaveX(ctx)
+	child := client.Todo.Create().SetName("child").SetParent(parent).SaveX(ctx)
+	other := client.Todo.Create().SetName("other").SetOwner(nati).SaveX(ctx)
+
+	// The owned todos and their children are soft-deleted along with the user.
+	if n := client.User.Delete().Where(user.ID(a8m.ID)).ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of deleted users: %d", n)
+	}
+	if ids := client.Todo.Query().IDsX(ctx); len(ids) != 1 || ids[0] != other.ID {
+		t.Errorf("unexpected live todos: %v", ids)
+	}
+	u := client.User.GetX(all, a8m.ID)
+	for _, id := range []int{parent.ID, child.ID} {
+		if td := client.Todo.GetX(all, id); td.DeletedTime.IsZero() || !td.DeletedTime.Equal(u.DeletedTime) {
+			t.Errorf("expected todo %d to be deleted with the user: %v", id, td.DeletedTime)
+		}
+	}
+
+	// Cycles are traversed once.
+	a := client.Todo.Create().SetName("a").SaveX(ctx)
+	b := client.Todo.Create().SetName("b").SetParent(a).SaveX(ctx)
+	a = a.Update().SetParent(b).SaveX(ctx)
+	if n := client.Todo.Delete().Where(todo.ID(a.ID)).ExecX(ctx); n != 1 {
+		t.Errorf("unexpected number of deleted todos: %d", n)
+	}
+	if client.Todo.Query().Where(todo.IDIn(a.ID, b.ID)).ExistX(ctx) {
+		t.Error("expected the todo cycle to be deleted")
+	}
+
+	// The cascade joins the transaction of the client.
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatalf("could not start a transaction: %v", err)
+	}
+	tx.User.DeleteOne(nati).ExecX(ctx)
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("could not rollback the transaction: %v", err)
+	}
+	if !client.User.Query().Where(user.ID(nati.ID)).ExistX(ctx) || !client.Todo.Query().Where(todo.ID(other.ID)).ExistX(ctx) {
+		t.Error("expected the cascade to be rolled back")
+	}
+
+	// A soft delete forced in a hard-delete context soft-deletes the cascade as well.
+	hard := schema.WithSkipDeletedTimeHook(ctx)
+	if n, err := client.User.SetDeletedTime(hard, time.Now(), nati.ID); err != nil || n != 1 {
+		t.Errorf("unexpected result of a forced soft delete: %d, %v", n, err)
+	}
+	if td, err := client.Todo.Get(all, other.ID); err != nil || td.DeletedTime.IsZero() {
+		t.Errorf("expected the todo to be soft-deleted with its user: %v, %v", td, err)
+	}
+}
+
+func testSoftDeleteMany(t *testing.T, db database) {
+	var restored int
+	l := ent.NewLifecycle()
+	l.User.BeforeRestore(func(_ context.Context, _ *ent.Client, ids []int) error {
+		restored += len(ids)
+		return nil
+	})
+	// The client does not log its statements, as they cover tens of thousands of rows.
+	client := db.open(t, ent.LifecycleCallbacks(l))
+	reset(t, client)
+	ctx := context.Background()
+
+	// The soft delete, its edge actions and the restore match more rows than the
+	// databases accept bound parameters in a statement.
+	const n, bulk = 40000, 1000
+	for i := 0; i < n; i += bulk {
+		users := make([]*ent.UserCreate, bulk)
+		for j := range users {
+			users[j] = client.User.Create().SetName("user" + strconv.Itoa(i+j)).SetAge(i + j)
+		}
+		client.User.CreateBulk(users...).ExecX(ctx)
+	}
+	u := client.User.Query().Order(ent.Desc(user.FieldID)).FirstX(ctx)
+	td := client.Todo.Create().SetName("todo").SetOwner(u).SaveX(ctx)
+	if d, err := client.User.Delete().Exec(ctx); err != nil || d != n {
+		t.Fatalf("unexpected result of deleting the users: %d, %v", d, err)
+	}
+	if client.Todo.Query().Where(todo.ID(td.ID)).ExistX(ctx) {
+		t.Error("expected the todo to be soft-deleted with its user")
+	}
+	if r, err := client.User.Restore().Exec(ctx); err != nil || r != n || restored != n {
+		t.Errorf("unexpected result of restoring the users: %d, %d, %v", r, restored, err)
+	}
+	reset(t, client)
+}
+
+func testRestoreEdgePredicates(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	l := ent.NewLifecycle()
+	l.User.BeforeRestore(func(context.Context, *ent.Client, []int) error { return nil })
+	callbacks := db.open(t, ent.LifecycleCallbacks(l))
+
+	// Registering callbacks does not change the rows restored: the edge predicates
+	// match the live neighbors, and the todos were soft-deleted with their owners.
+	for _, c := range []*ent.Client{client, callbacks} {
+		a8m := c.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+		c.Todo.Create().SetName("todo").SetOwner(a8m).SaveX(ctx)
+		c.User.DeleteOne(a8m).ExecX(ctx)
+		if n := c.User.Restore().Where(user.HasTodos()).ExecX(ctx); n != 0 {
+			t.Errorf("unexpected number of users restored over live todos: %d", n)
+		}
+		if n := c.User.Restore().Where(user.HasTodos()).ExecX(schema.WithIncludeDeleted(ctx)); n != 1 {
+			t.Errorf("unexpected number of users restored over all todos: %d", n)
+		}
+		reset(t, client)
+	}
+}
+
+func testSoftDeleteActions(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+
+	// Live sessions restrict the soft delete of their user.
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	s := client.Session.Create().SetToken("secret").SaveX(ctx)
+	a8m.Update().AddSessions(s).ExecX(ctx)
+	var rerr *ent.RestrictedError
+	if err := client.User.DeleteOne(a8m).Exec(ctx); !errors.As(err, &rerr) || !ent.IsRestricted(err) {
+		t.Fatalf("expected a restricted error: %v", err)
+	}
+	if rerr.Type != ent.TypeUser || rerr.Edge != user.EdgeSessions || len(rerr.IDs) != 1 || rerr.IDs[0] != s.ID {
+		t.Errorf("unexpected restricted error: %+v", rerr)
+	}
+	if !client.User.Query().Where(user.ID(a8m.ID)).ExistX(ctx) {
+		t.Error("expected the restricted user to stay live")
+	}
+	client.Session.DeleteOne(s).ExecX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+
+	// Tags are detached from their soft-deleted todo.
+	td := client.Todo.Create().SetName("todo").SaveX(ctx)
+	tg := client.Tag.Create().SetName("tag").SaveX(ctx)
+	td.Update().AddTags(tg).ExecX(ctx)
+	client.Todo.DeleteOne(td).ExecX(ctx)
+	if n := client.Todo.QueryTags(td).CountX(all); n != 0 {
+		t.Errorf("expected the tags to be detached: %d", n)
+	}
+	if !client.Tag.Query().ExistX(ctx) {
+		t.Error("expected the detached tag to stay live")
+	}
+}
+
+func testRestoreBatch(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	trash := schema.WithOnlyDeleted(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	done := client.Todo.Create().SetName("done").SetOwner(a8m).SaveX(ctx)
+	open := client.Todo.Create().SetName("open").SetOwner(a8m).SaveX(ctx)
+	client.Todo.DeleteOne(done).ExecX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+
+	done = client.Todo.GetX(trash, done.ID)
+	u := client.User.GetX(trash, a8m.ID)
+	if u.DeletedBatch == "" || u.DeletedBatch == done.DeletedBatch {
+		t.Errorf("unexpected deletion batches: %q, %q", u.DeletedBatch, done.DeletedBatch)
+	}
+	if td := client.Todo.GetX(trash, open.ID); td.DeletedBatch != u.DeletedBatch {
+		t.Errorf("expected the cascade to share the batch of the user: %q", td.DeletedBatch)
+	}
+
+	// Only the rows deleted along with the user are restored.
+	n, err := ent.RestoreBatchForType(ctx, client, ent.TypeUser, a8m.ID)
+	if err != nil || n != 2 {
+		t.Errorf("unexpected result of restoring the batch of a user: %d, %v", n, err)
+	}
+	if ids := client.Todo.Query().IDsX(ctx); len(ids) != 1 || ids[0] != open.ID {
+		t.Errorf("unexpected live todos: %v", ids)
+	}
+	if u := client.User.GetX(ctx, a8m.ID); u.DeletedBatch != "" {
+		t.Errorf("expected the deletion batch to be cleared: %q", u.DeletedBatch)
+	}
+
+	if n, err := ent.RestoreBatch(ctx, client, done.DeletedBatch); err != nil || n != 1 {
+		t.Errorf("unexpected result of restoring a batch: %d, %v", n, err)
+	}
+	if _, err := ent.RestoreBatchForType(ctx, client, ent.TypeUser, a8m.ID); !ent.IsNotFound(err) {
+		t.Errorf("expected a not found error for a live user: %v", err)
+	}
+	client.User.UpdateOneID(a8m.ID).SetDeletedTime(time.Now()).ExecX(ctx)
+	if _, err := ent.RestoreBatchForType(ctx, client, ent.TypeUser, a8m.ID); !ent.IsNoDeletionBatch(err) {
+		t.Errorf("expected an error for a user deleted without a batch: %v", err)
+	}
+}
+
+func testPurge(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	users := client.User.CreateBulk(
+		client.User.Create().SetName("a8m").SetAge(30),
+		client.User.Create().SetName("nati").SetAge(28),
+		client.User.Create().SetName("ariel").SetAge(32),
+		client.User.Create().SetName("pedro").SetAge(28),
+	).SaveX(ctx)
+	if _, err := client.User.SetDeletedTime(ctx, cutoff.Add(-time.Hour), users[0].ID, users[1].ID, users[2].ID); err != nil {
+		t.Fatalf("could not stamp the users: %v", err)
+	}
+	client.User.DeleteOne(users[2]).ExecX(schema.WithRestampDeletedTime(ctx))
+
+	// Only the rows deleted before the cutoff are removed, in batches of one.
+	// query=SELECT `users`.`id` FROM `users` WHERE `users`.`deleted_time` IS NOT NULL AND `users`.`deleted_time` < ? LIMIT 1 args=[2022-08-12 20:42:34.613814 -0300 -03]
+	// query=DELETE FROM `users` WHERE `users`.`id` IN (?) AND (`users`.`deleted_time` IS NOT NULL AND `users`.`deleted_time` < ?) args=[1 2022-08-12 20:42:34.613814 -0300 -03]
+	if n := client.User.Purge().Before(cutoff).BatchSize(1).ExecX(ctx); n != 2 {
+		t.Errorf("unexpected number of purged users: %d", n)
+	}
+	if ids := client.User.Query().Order(ent.Asc(user.FieldID)).IDsX(all); len(ids) != 2 || ids[0] != users[2].ID || ids[1] != users[3].ID {
+		t.Errorf("unexpected users after purge: %v", ids)
+	}
+	if _, err := client.User.Purge().Exec(ctx); !ent.IsMissingCutoff(err) {
+		t.Errorf("expected an error for a purge without a cutoff: %v", err)
+	}
+
+	ev := client.Event.Create().SetName("event").SaveX(ctx)
+	if _, err := client.Event.SetDeletedTime(ctx, cutoff.Add(-time.Hour), ev.ID); err != nil {
+		t.Fatalf("could not stamp the event: %v", err)
+	}
+	counts, err := client.Purge(ctx, ent.PurgeOptions{Before: time.Now()})
+	if err != nil {
+		t.Fatalf("could not purge: %v", err)
+	}
+	if counts[ent.TypeUser] != 1 || counts[ent.TypeEvent] != 1 || counts[ent.TypeTodo] != 0 {
+		t.Errorf("unexpected purge counts: %v", counts)
+	}
+	if _, ok := counts[ent.TypeTag]; ok {
+		t.Errorf("expected tags to be skipped: %v", counts)
+	}
+	if _, err := client.Purge(ctx, ent.PurgeOptions{Before: time.Now(), Types: []string{ent.TypeTag}}); !ent.IsNoDeletionTime(err) {
+		t.Errorf("expected an error for purging tags by a cutoff: %v", err)
+	}
+}
+
+func testPurgeExpired(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+	day := 24 * time.Hour
+
+	if d, ok := ent.RetentionPolicy(ent.TypeUser); !ok || d != 90*day {
+		t.Errorf("unexpected retention of users: %v", d)
+	}
+	if _, ok := ent.RetentionPolicy(ent.TypeEvent); ok {
+		t.Error("expected events to declare no retention")
+	}
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	expired := client.Todo.Create().SetName("expired").SaveX(ctx)
+	retained := client.Todo.Create().SetName("retained").SaveX(ctx)
+	ev := client.Event.Create().SetName("event").SaveX(ctx)
+	if _, err := client.User.SetDeletedTime(ctx, time.Now().Add(-30*day), a8m.ID); err != nil {
+		t.Fatalf("could not stamp the user: %v", err)
+	}
+	if _, err := client.Todo.SetDeletedTime(ctx, time.Now().Add(-8*day), expired.ID); err != nil {
+		t.Fatalf("could not stamp the todo: %v", err)
+	}
+	if _, err := client.Todo.SetDeletedTime(ctx, time.Now().Add(-6*day), retained.ID); err != nil {
+		t.Fatalf("could not stamp the todo: %v", err)
+	}
+	if _, err := client.Event.SetDeletedTime(ctx, time.Now().Add(-day), ev.ID); err != nil {
+		t.Fatalf("could not stamp the event: %v", err)
+	}
+
+	counts, err := client.PurgeExpired(ctx, ent.PurgeOptions{})
+	if err != nil {
+		t.Fatalf("could not purge the expired rows: %v", err)
+	}
+	if counts[ent.TypeTodo] != 1 || counts[ent.TypeUser] != 0 || len(counts) != 2 {
+		t.Errorf("unexpected purge counts: %v", counts)
+	}
+	if ids := client.Todo.Query().IDsX(all); len(ids) != 1 || ids[0] != retained.ID {
+		t.Errorf("unexpected todos after purge: %v", ids)
+	}
+
+	// Types without a retention policy are purged only when forced.
+	opts := ent.PurgeOptions{Before: time.Now(), Types: []string{ent.TypeEvent}}
+	if _, err := client.PurgeExpired(ctx, opts); !ent.IsNoRetentionPolicy(err) {
+		t.Errorf("expected an error for purging events without a retention policy: %v", err)
+	}
+	opts.Force = true
+	if counts, err := client.PurgeExpired(ctx, opts); err != nil || counts[ent.TypeEvent] != 1 {
+		t.Errorf("unexpected result of a forced purge: %v, %v", counts, err)
+	}
+}
+
+func testPurgeWorker(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	client.PurgeLease.Delete().ExecX(ctx)
+	expired := time.Now().Add(-8 * 24 * time.Hour)
+
+	todos := client.Todo.CreateBulk(
+		client.Todo.Create().SetName("a"),
+		client.Todo.Create().SetName("b"),
+		client.Todo.Create().SetName("c"),
+	).SaveX(ctx)
+	if _, err := client.Todo.SetDeletedTime(ctx, expired, todos[0].ID); err != nil {
+		t.Fatalf("could not stamp the todo: %v", err)
+	}
+
+	// Only the holder of the lease purges. The lease expires against the clock of the workers.
+	now := time.Now()
+	clock := func() time.Time { return now }
+	w1 := ent.NewPurgeWorker(client, ent.PurgeWorkerConfig{Lease: "test", Holder: "w1", LeaseTTL: time.Minute, Now: clock})
+	w2 := ent.NewPurgeWorker(client, ent.PurgeWorkerConfig{Lease: "test", Holder: "w2", LeaseTTL: time.Minute, Now: clock})
+	if counts, err := w1.RunOnce(ctx); err != nil || counts[ent.TypeTodo] != 1 {
+		t.Errorf("unexpected result of the lease holder run: %v, %v", counts, err)
+	}
+	if counts, err := w2.RunOnce(ctx); err != nil || counts != nil {
+		t.Errorf("expected the run to be skipped without the lease: %v, %v", counts, err)
+	}
+	now = now.Add(30 * time.Second)
+	if counts, err := w1.RunOnce(ctx); err != nil || counts == nil {
+		t.Errorf("expected the lease to be renewed by its holder: %v, %v", counts, err)
+	}
+	now = now.Add(45 * time.Second)
+	if counts, err := w2.RunOnce(ctx); err != nil || counts != nil {
+		t.Errorf("expected the renewed lease to be held: %v, %v", counts, err)
+	}
+	// An expired lease is taken over by another worker.
+	now = now.Add(time.Minute)
+	if counts, err := w2.RunOnce(ctx); err != nil || counts == nil {
+		t.Errorf("expected the expired lease to be taken over: %v, %v", counts, err)
+	}
+	if l := client.PurgeLease.GetX(ctx, "test"); l.Holder != "w2" {
+		t.Errorf("unexpected holder of the lease: %q", l.Holder)
+	}
+
+	// The rate limit is applied between batches.
+	if _, err := client.Todo.SetDeletedTime(ctx, expired, todos[1].ID, todos[2].ID); err != nil {
+		t.Fatalf("could not stamp the todos: %v", err)
+	}
+	w3 := ent.NewPurgeWorker(client, ent.PurgeWorkerConfig{
+		PurgeOptions: ent.PurgeOptions{BatchSize: 1, RowsPerSecond: 20},
+		Lease:        "rate",
+	})
+	start := time.Now()
+	if counts, err := w3.RunOnce(ctx); err != nil || counts[ent.TypeTodo] != 2 {
+		t.Errorf("unexpected result of a rate limited run: %v, %v", counts, err)
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("expected the batches to be paced by the rate limit: %v", elapsed)
+	}
+
+	// The worker stops on cancellation and releases its lease. The clock cancels
+	// the context when the second run starts, after the first one completed.
+	rctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var runs int
+	w4 := ent.NewPurgeWorker(client, ent.PurgeWorkerConfig{
+		Interval: time.Millisecond,
+		Lease:    "run",
+		Now: func() time.Time {
+			if runs++; runs == 2 {
+				cancel()
+			}
+			return time.Now()
+		},
+	})
+	if err := w4.Run(rctx); !errors.Is(err, context.Canceled) || runs != 2 {
+		t.Errorf("expected the worker to stop on cancellation: %d, %v", runs, err)
+	}
+	if client.PurgeLease.Query().Where(purgelease.ID("run")).ExistX(ctx) {
+		t.Error("expected the lease to be released on cancellation")
+	}
+
+	// Failed runs are retried with backoff. The first two runs fail, the third
+	// one purges and the clock cancels the context when the fourth one starts.
+	todo := client.Todo.Create().SetName("d").SaveX(ctx)
+	if _, err := client.Todo.SetDeletedTime(ctx, expired, todo.ID); err != nil {
+		t.Fatalf("could not stamp the todo: %v", err)
+	}
+	c := db.open(t)
+	var failures int
+	c.PurgeLease.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate) && failures < 2 {
+				failures++
+				return nil, errors.New("transient error")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	rctx, cancel = context.WithCancel(ctx)
+	defer cancel()
+	runs = 0
+	var handled int
+	w5 := ent.NewPurgeWorker(c, ent.PurgeWorkerConfig{
+		Interval:     time.Millisecond,
+		Lease:        "retry",
+		ErrorHandler: func(error) { handled++ },
+		Now: func() time.Time {
+			if runs++; runs == 4 {
+				cancel()
+			}
+			return time.Now()
+		},
+	})
+	if err := w5.Run(rctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the worker to retry until cancellation: %v", err)
+	}
+	if handled != 2 {
+		t.Errorf("unexpected number of handled errors: %d", handled)
+	}
+	if n := client.Todo.Query().CountX(schema.WithOnlyDeleted(ctx)); n != 0 {
+		t.Errorf("expected the todos to be purged after the retries: %d", n)
+	}
+}
+
+func testLiveIndexes(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	if !db.live {
+		if err := client.Schema.Create(ctx, migrate.WithLiveIndexes()); err == nil {
+			t.Error("expected the live indexes to be rejected by the server")
+		}
+		return
+	}
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	if err := client.User.Create().SetName("a8m").SetAge(31).Exec(ctx); !ent.IsConstraintError(err) {
+		t.Errorf("expected a constraint error for a duplicate live name: %v", err)
+	}
+
+	// The name of a soft-deleted user can be reused.
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	again := client.User.Create().SetName("a8m").SetAge(31).SaveX(ctx)
+	client.User.DeleteOne(again).ExecX(ctx)
+	client.User.Create().SetName("a8m").SetAge(32).SaveX(ctx)
+	if err := client.User.RestoreOne(a8m).Exec(ctx); !ent.IsConstraintError(err) {
+		t.Errorf("expected a constraint error for restoring a taken name: %v", err)
+	}
+
+	// The unique constraints created without the option are dropped by it.
+	reset(t, client)
+	drv, err := entsql.Open(db.dialect, db.dsn)
+	if err != nil {
+		t.Fatalf("could not open the database: %v", err)
+	}
+	defer drv.Close()
+	if err := drv.Exec(ctx, "CREATE UNIQUE INDEX users_name ON users (name)", []interface{}{}, nil); err != nil {
+		t.Fatalf("could not create a plain unique index: %v", err)
+	}
+	a8m = client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+	if err := client.User.Create().SetName("a8m").SetAge(31).Exec(ctx); !ent.IsConstraintError(err) {
+		t.Fatalf("expected a constraint error without live indexes: %v", err)
+	}
+	if err := client.Schema.Create(ctx, migrate.WithLiveIndexes()); err != nil {
+		t.Fatalf("could not migrate with live indexes: %v", err)
+	}
+	client.User.Create().SetName("a8m").SetAge(31).SaveX(ctx)
+	if err := client.User.Create().SetName("a8m").SetAge(32).Exec(ctx); !ent.IsConstraintError(err) {
+		t.Errorf("expected a constraint error for a duplicate live name: %v", err)
+	}
+}
+
+func testDeletedTimeIndexes(t *testing.T) {
+	for _, tb := range []*sqlschema.Table{migrate.UsersTable, migrate.TodosTable, migrate.SessionsTable, migrate.TagsTable, migrate.EventsTable} {
+		if len(tb.Indexes) == 0 || len(tb.Indexes[0].Columns) != 1 || tb.Indexes[0].Columns[0] != tb.Columns[1] {
+			t.Errorf("expected an index on the soft-delete column of %s", tb.Name)
+		}
+	}
+	idx, ok := migrate.TodosTable.Index("todo_user_todos_deleted_time")
+	if !ok || idx.Unique || len(idx.Columns) != 2 || idx.Columns[0].Name != "user_todos" || idx.Columns[1].Name != "deleted_time" {
+		t.Errorf("expected the soft-delete column to be appended to the owner index: %v", idx)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected the soft-delete field to be rejected on edge indexes")
+			}
+		}()
+		schema.DeletedTime{}.AppendIndexes(index.Edges("owner"))
+	}()
+}
+
+func testEdgeFilter(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Todo.Create().SetName("live").SetOwner(a8m).SaveX(ctx)
+	gone := client.Todo.Create().SetName("gone").SetOwner(a8m).SaveX(ctx)
+	natis := client.Todo.Create().SetName("nati").SetOwner(nati).SaveX(ctx)
+	client.Todo.Delete().Where(todo.IDIn(gone.ID, natis.ID)).ExecX(ctx)
+
+	// Traversals and eager loading skip the soft-deleted neighbors.
+	if n := a8m.QueryTodos().CountX(ctx); n != 1 {
+		t.Errorf("unexpected number of live todos: %d", n)
+	}
+	if n := a8m.QueryTodos().CountX(all); n != 2 {
+		t.Errorf("unexpected number of todos: %d", n)
+	}
+	if n := client.User.QueryTodos(a8m).CountX(schema.WithOnlyDeleted(ctx)); n != 1 {
+		t.Errorf("unexpected number of deleted todos: %d", n)
+	}
+	u := client.User.Query().Where(user.ID(a8m.ID)).WithTodos().OnlyX(ctx)
+	if len(u.Edges.Todos) != 1 || u.Edges.Todos[0].Name != "live" {
+		t.Errorf("unexpected eager-loaded todos: %v", u.Edges.Todos)
+	}
+	u = client.User.Query().Where(user.ID(a8m.ID)).WithTodos().OnlyX(all)
+	if len(u.Edges.Todos) != 2 {
+		t.Errorf("unexpected eager-loaded todos: %v", u.Edges.Todos)
+	}
+
+	// Edge predicates match the live neighbors only.
+	if names := client.User.Query().Where(user.HasTodos()).Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx); len(names) != 1 || names[0] != "a8m" {
+		t.Errorf("unexpected users with live todos: %v", names)
+	}
+	if n := client.User.Query().Where(user.HasTodos()).CountX(all); n != 2 {
+		t.Errorf("unexpected number of users with todos: %d", n)
+	}
+	if client.User.Query().Where(user.HasTodosWith(todo.Name("gone"))).ExistX(ctx) {
+		t.Error("expected the soft-deleted todo to be hidden from the edge predicate")
+	}
+	if !client.User.Query().Where(user.HasTodosWith(todo.Name("gone"))).ExistX(all) {
+		t.Error("expected the soft-deleted todo to match with the deleted rows included")
+	}
+	client.User.DeleteOne(nati).ExecX(ctx)
+	client.Todo.RestoreOne(natis).ExecX(ctx)
+	if n := client.Todo.Query().Where(todo.HasOwner()).CountX(ctx); n != 1 {
+		t.Errorf("unexpected number of todos with a live owner: %d", n)
+	}
+}
+
+func testUpdateDeleted(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+
+	// Update builders skip the soft-deleted rows.
+	if n := client.User.Update().AddAge(1).SaveX(ctx); n != 1 {
+		t.Errorf("unexpected number of updated users: %d", n)
+	}
+	if u := client.User.GetX(all, a8m.ID); u.Age != 30 {
+		t.Errorf("expected the soft-deleted user to stay untouched: %d", u.Age)
+	}
+
+	// Update-one builders report the soft-deleted entity.
+	err := client.User.UpdateOneID(a8m.ID).SetAge(31).Exec(ctx)
+	var derr *ent.DeletedError
+	if !errors.As(err, &derr) || !ent.IsDeleted(err) {
+		t.Fatalf("expected a deleted error: %v", err)
+	}
+	if derr.Type != ent.TypeUser || derr.ID != a8m.ID || derr.DeletedTime.IsZero() {
+		t.Errorf("unexpected deleted error: %+v", derr)
+	}
+	if err := client.User.UpdateOneID(nati.ID + 100).SetAge(31).Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("expected a not found error: %v", err)
+	}
+	s := client.Session.Create().SetToken("secret").SaveX(ctx)
+	client.Session.DeleteOne(s).ExecX(ctx)
+	if err := client.Session.UpdateOne(s).SetToken("other").Exec(ctx); !ent.IsDeleted(err) {
+		t.Errorf("expected a deleted error for the session: %v", err)
+	}
+
+	// Administrative edits opt in to modify soft-deleted rows.
+	client.User.UpdateOneID(a8m.ID).SetAge(31).ExecX(schema.WithUpdateDeleted(ctx))
+	if u := client.User.GetX(all, a8m.ID); u.Age != 31 || u.DeletedTime.IsZero() {
+		t.Errorf("unexpected soft-deleted user after the administrative edit: %+v", u)
+	}
+	client.User.RestoreOne(a8m).ExecX(ctx)
+	client.User.UpdateOneID(a8m.ID).SetAge(32).ExecX(ctx)
+
+	// Update-one builders setting the soft-delete field return the updated entity.
+	at := time.Now().Add(-time.Hour).Truncate(time.Second)
+	u, err := client.User.UpdateOneID(nati.ID).SetDeletedTime(at).Save(ctx)
+	if err != nil || !u.DeletedTime.Equal(at) {
+		t.Fatalf("unexpected result of stamping a live user: %v, %v", u, err)
+	}
+	if client.User.Query().Where(user.ID(nati.ID)).ExistX(ctx) {
+		t.Error("expected the stamped user to be soft-deleted")
+	}
+
+	// Setting the soft-delete field does not allow editing soft-deleted entities.
+	_, err = client.User.UpdateOneID(nati.ID).SetDeletedTime(time.Now()).SetName("hacked").Save(ctx)
+	if !ent.IsDeleted(err) {
+		t.Errorf("expected a deleted error for the edit of a soft-deleted user: %v", err)
+	}
+	if u := client.User.GetX(all, nati.ID); u.Name != "nati" || !u.DeletedTime.Equal(at) {
+		t.Errorf("expected the soft-deleted user to stay untouched: %+v", u)
+	}
+}
+
+func testDeletedErrors(t *testing.T, client *ent.Client) {
+	reset(t, client)
+	ctx := context.Background()
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.DeleteOne(a8m).ExecX(ctx)
+
+	// Soft-deleted entities are still reported as not found, and can be told
+	// apart from the missing ones.
+	_, err := client.User.Get(ctx, a8m.ID)
+	if !ent.IsNotFound(err) {
+		t.Fatalf("expected a not found error: %v", err)
+	}
+	err = client.User.DeletedError(ctx, a8m.ID)
+	var derr *ent.DeletedError
+	if !errors.As(err, &derr) || !ent.IsNotFound(err) {
+		t.Fatalf("expected a deleted error: %v", err)
+	}
+	if derr.Type != ent.TypeUser || derr.ID != a8m.ID || derr.DeletedTime.IsZero() {
+		t.Errorf("unexpected deleted error: %+v", derr)
+	}
+	if err := client.User.DeletedError(ctx, nati.ID); err != nil {
+		t.Errorf("unexpected error for a live user: %v", err)
+	}
+	if err := client.User.DeletedError(ctx, nati.ID+100); err != nil {
+		t.Errorf("unexpected error for a missing user: %v", err)
+	}
+	if err := client.User.DeleteOne(a8m).Exec(ctx); !ent.IsDeleted(err) || !ent.IsNotFound(err) {
+		t.Errorf("expected a deleted error for deleting twice: %v", err)
+	}
+	if err := client.User.DeleteOneID(nati.ID + 100).Exec(ctx); !ent.IsNotFound(err) || ent.IsDeleted(err) {
+		t.Errorf("expected a not found error for a missing user: %v", err)
+	}
+
+	// Unknown types are reported with a typed error.
+	if _, err := ent.RestoreBatchForType(ctx, client, ent.TypeOther, 1); !ent.IsUnsupportedType(err) {
+		t.Errorf("expected an unsupported type error: %v", err)
+	}
+	if _, err := client.Purge(ctx, ent.PurgeOptions{Before: time.Now(), Types: []string{ent.TypeOther}}); !ent.IsUnsupportedType(err) {
+		t.Errorf("expected an unsupported type error: %v", err)
+	}
+}
+
+func testLifecycle(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	l := ent.NewLifecycle()
+	client = db.open(t, ent.LifecycleCallbacks(l))
+
+	var events []string
+	record := func(event string) ent.SessionCallback {
+		return func(ctx context.Context, c *ent.Client, ids []uuid.UUID) error {
+			events = append(events, fmt.Sprintf("%s %d", event, len(ids)))
+			return nil
+		}
+	}
+	l.Session.BeforeSoftDelete(record("before delete"))
+	l.Session.AfterSoftDelete(record("after delete"))
+	l.Session.BeforeRestore(record("before restore"))
+	l.Session.AfterRestore(record("after restore"))
+	l.Session.BeforePurge(record("before purge"))
+	l.Session.AfterPurge(record("after purge"))
+	l.User.BeforeSoftDelete(func(ctx context.Context, c *ent.Client, ids []int) error {
+		if c.User.Query().Where(user.IDIn(ids...), user.NameHasPrefix("keep")).ExistX(ctx) {
+			return errors.New("user is kept")
+		}
+		return nil
+	})
+
+	s1 := client.Session.Create().SetToken("s1").SaveX(ctx)
+	client.Session.Create().SetToken("s2").SaveX(ctx)
+	if n := client.Session.Delete().ExecX(ctx); n != 2 {
+		t.Errorf("unexpected number of deleted sessions: %d", n)
+	}
+	client.Session.RestoreOne(s1).ExecX(ctx)
+	client.Session.DeleteOne(s1).ExecX(ctx)
+	if n := client.Session.Purge().Before(time.Now().Add(time.Minute)).ExecX(ctx); n != 2 {
+		t.Errorf("unexpected number of purged sessions: %d", n)
+	}
+	expected := []string{
+		"before delete 2", "after delete 2",
+		"before restore 1", "after restore 1",
+		"before delete 1", "after delete 1",
+		"before purge 2", "after purge 2",
+	}
+	if fmt.Sprint(events) != fmt.Sprint(expected) {
+		t.Errorf("unexpected callbacks: %v", events)
+	}
+
+	// A before callback vetoes the operation.
+	keeper := client.User.Create().SetName("keeper").SetAge(30).SaveX(ctx)
+	if err := client.User.DeleteOne(keeper).Exec(ctx); err == nil || err.Error() != "user is kept" {
+		t.Errorf("expected the soft delete to be vetoed: %v", err)
+	}
+	if !client.User.Query().Where(user.ID(keeper.ID)).ExistX(ctx) {
+		t.Error("expected the vetoed user to stay live")
+	}
+}
+
+func testDeleteKind(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	client = db.open(t)
+
+	var kinds []string
+	record := func(kind string) ent.Hook {
+		return func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				kinds = append(kinds, kind)
+				return next.Mutate(ctx, m)
+			})
+		}
+	}
+	client.Todo.Use(
+		hook.If(record("soft"), hook.IsSoftDelete()),
+		hook.If(record("hard"), hook.IsHardDelete()),
+		hook.If(record("stamp"), hook.IsSoftDeleteUpdate()),
+	)
+
+	td := client.Todo.Create().SetName("todo").SaveX(ctx)
+	td.Update().SetName("renamed").ExecX(ctx)
+	client.Todo.DeleteOne(td).ExecX(ctx)
+	client.Todo.DeleteOne(td).ExecX(schema.WithSkipDeletedTimeHook(ctx))
+	if expected := []string{"soft", "stamp", "hard"}; fmt.Sprint(kinds) != fmt.Sprint(expected) {
+		t.Errorf("unexpected delete kinds: %v", kinds)
+	}
+	if k := ent.DeleteKindOf(ctx, client.Todo.Update().Mutation()); k != ent.DeleteKindNone {
+		t.Errorf("unexpected delete kind of an update: %v", k)
+	}
+}
+
+func testHardDelete(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	all := schema.WithIncludeDeleted(ctx)
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.HardDeleteOne(a8m).ExecX(ctx)
+	if client.User.Query().Where(user.ID(a8m.ID)).ExistX(all) {
+		t.Error("expected the user to be removed")
+	}
+	if err := client.User.HardDeleteOneID(a8m.ID).Exec(ctx); !ent.IsNotFound(err) {
+		t.Errorf("expected a not found error: %v", err)
+	}
+	client.User.DeleteOne(nati).ExecX(ctx)
+	if n := client.User.HardDelete().Where(user.Name("nati")).ExecX(ctx); n != 1 {
+		t.Errorf("expected the soft-deleted user to be removed: %d", n)
+	}
+
+	// The bypass does not spread to the deletes that hooks execute with the same context.
+	client = db.open(t)
+	client.Tag.Use(hook.If(func(next ent.Mutator) ent.Mutator {
+		return hook.TagFunc(func(ctx context.Context, m *ent.TagMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil {
+				_, err = m.Client().Todo.Delete().Where(todo.Name("nested")).Exec(ctx)
+			}
+			return v, err
+		})
+	}, hook.IsHardDelete()))
+	client.Todo.Create().SetName("nested").SaveX(ctx)
+	client.Tag.Create().SetName("tag").SaveX(ctx)
+	client.Tag.HardDelete().ExecX(ctx)
+	if client.Tag.Query().ExistX(all) {
+		t.Error("expected the tags to be removed")
+	}
+	if n := client.Todo.Query().Where(todo.Name("nested")).CountX(schema.WithOnlyDeleted(ctx)); n != 1 {
+		t.Errorf("expected the nested delete to soft-delete the todo: %d", n)
+	}
+}
+
+type viewerCtxKey struct{}
+
+func testBypassPolicy(t *testing.T, client *ent.Client, db database) {
+	reset(t, client)
+	ctx := context.Background()
+	admin := context.WithValue(ctx, viewerCtxKey{}, "admin")
+	auditor := context.WithValue(ctx, viewerCtxKey{}, "auditor")
+	client = db.open(t, ent.SoftDeletePolicy(
+		ent.BypassRuleFunc(func(ctx context.Context, _ string, _ ent.BypassAction) error {
+			if v, _ := ctx.Value(viewerCtxKey{}).(string); v == "admin" {
+				return privacy.Allow
+			}
+			return privacy.Skip
+		}),
+		ent.BypassRuleFunc(func(ctx context.Context, typ string, action ent.BypassAction) error {
+			if v, _ := ctx.Value(viewerCtxKey{}).(string); v == "auditor" && typ == ent.TypeUser && action == ent.BypassViewDeleted {
+				return privacy.Allow
+			}
+			return privacy.Skip
+		}),
+		ent.BypassRuleFunc(func(context.Context, string, ent.BypassAction) error {
+			return privacy.Deny
+		}),
+	))
+
+	// Soft deletes do not bypass the soft delete, restores and edits of soft-deleted rows do.
+	u := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.Todo.Create().SetName("a8m").SetOwner(u).SaveX(ctx)
+	client.User.DeleteOne(u).ExecX(ctx)
+	err := client.User.RestoreOne(u).Exec(ctx)
+	var derr *ent.BypassDeniedError
+	if !errors.As(err, &derr) || derr.Type != ent.TypeUser || derr.Action != ent.BypassRestore {
+		t.Errorf("expected the restore to be denied: %v", err)
+	}
+	if _, err := ent.RestoreBatchForType(ctx, client, ent.TypeUser, u.ID); !ent.IsBypassDenied(err) {
+		t.Errorf("expected the restore of the batch to be denied: %v", err)
+	}
+	err = client.User.UpdateOne(u).SetAge(31).Exec(schema.WithUpdateDeleted(ctx))
+	if !errors.As(err, &derr) || derr.Action != ent.BypassUpdateDeleted {
+		t.Errorf("expected the edit of the soft-deleted user to be denied: %v", err)
+	}
+	if _, err := client.User.Update().SetAge(31).Save(schema.WithUpdateDeleted(ctx)); !ent.IsBypassDenied(err) {
+		t.Errorf("expected the update of soft-deleted users to be denied: %v", err)
+	}
+	client.User.RestoreOne(u).ExecX(admin)
+	client.User.UpdateOne(u).SetAge(31).ExecX(schema.WithUpdateDeleted(admin))
+	client.User.DeleteOne(u).ExecX(ctx)
+
+	err = client.User.HardDeleteOne(u).Exec(ctx)
+	if !errors.As(err, &derr) || derr.Type != ent.TypeUser || derr.Action != ent.BypassHardDelete || !errors.Is(err, privacy.Deny) {
+		t.Errorf("expected the hard delete to be denied: %v", err)
+	}
+	if _, err := client.User.Delete().Exec(schema.WithSkipDeletedTimeHook(ctx)); !ent.IsBypassDenied(err) {
+		t.Errorf("expected the skipped soft delete to be denied: %v", err)
+	}
+	if _, err := client.Purge(ctx, ent.PurgeOptions{Before: time.Now().Add(time.Hour), Types: []string{ent.TypeUser}}); !ent.IsBypassDenied(err) {
+		t.Errorf("expected the purge to be denied: %v", err)
+	}
+	_, err = client.User.Query().Where(user.Name("a8m")).All(schema.WithIncludeDeleted(ctx))
+	if !errors.As(err, &derr) || derr.Type != ent.TypeUser || derr.Action != ent.BypassViewDeleted || !errors.Is(err, privacy.Deny) {
+		t.Errorf("expected the query of soft-deleted users to be denied: %v", err)
+	}
+	if _, err := client.User.Query().Count(schema.WithOnlyDeleted(ctx)); !ent.IsBypassDenied(err) {
+		t.Errorf("expected the count of soft-deleted users to be denied: %v", err)
+	}
+	// The soft-deleted neighbors matched by the edge predicates are authorized by their type.
+	if n := client.User.Query().CountX(schema.WithOnlyDeleted(auditor)); n != 1 {
+		t.Errorf("expected the auditor to see the soft-deleted user: %d", n)
+	}
+	for _, p := range []predicate.User{user.HasTodos(), user.HasTodosWith(todo.Name("a8m"))} {
+		_, err := client.User.Query().Where(p).All(schema.WithIncludeDeleted(auditor))
+		if !errors.As(err, &derr) || derr.Type != ent.TypeTodo || derr.Action != ent.BypassViewDeleted {
+			t.Errorf("expected the query of soft-deleted todos to be denied: %v", err)
+		}
+	}
+	// So are the ones of updates, deletes and restores.
+	p := user.HasTodosWith(todo.Name("a8m"))
+	if _, err := client.User.Update().Where(p).SetAge(32).Save(schema.WithIncludeDeleted(auditor)); !errors.As(err, &derr) || derr.Type != ent.TypeTodo {
+		t.Errorf("expected the update over soft-deleted todos to be denied: %v", err)
+	}
+	if _, err := client.User.Delete().Where(p).Exec(schema.WithIncludeDeleted(auditor)); !errors.As(err, &derr) || derr.Type != ent.TypeTodo {
+		t.Errorf("expected the delete over soft-deleted todos to be denied: %v", err)
+	}
+	if _, err := client.User.Restore().Where(p).Exec(schema.WithIncludeDeleted(auditor)); !ent.IsBypassDenied(err) {
+		t.Errorf("expected the restore over soft-deleted todos to be denied: %v", err)
+	}
+	if n := client.User.Query().CountX(ctx); n != 0 {
+		t.Errorf("unexpected number of live users: %d", n)
+	}
+	// The lookups of soft-deleted rows that the generated code runs are not subject to the policy.
+	if err := client.User.UpdateOne(u).SetAge(31).Exec(ctx); !ent.IsDeleted(err) {
+		t.Errorf("expected a deleted error: %v", err)
+	}
+
+	// An admin viewer may bypass the soft delete.
+	if n := client.User.Query().CountX(schema.WithOnlyDeleted(admin)); n != 1 {
+		t.Errorf("expected the admin to see the soft-deleted user: %d", n)
+	}
+	client.User.HardDeleteOne(u).ExecX(admin)
+	u = client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.DeleteOne(u).ExecX(ctx)
+	if n := client.User.Purge().Before(time.Now().Add(time.Hour)).ExecX(admin); n != 1 {
+		t.Errorf("expected the admin to purge the soft-deleted user: %d", n)
+	}
+
+	// A privacy decision stored in the context takes precedence over the rules.
+	client.User.Create().SetName("system").SetAge(1).SaveX(ctx)
+	system := privacy.DecisionContext(ctx, privacy.Allow)
+	if n := client.User.Delete().ExecX(schema.WithSkipDeletedTimeHook(system)); n != 1 {
+		t.Errorf("expected the system to remove the user: %d", n)
+	}
 }
@@ -20,13 +20,22 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+
+	// lifecycle holds the callbacks of the soft-deletable types.
+	lifecycle *Lifecycle
+	// bypass authorizes the operations that bypass the soft delete.
+	bypass BypassPolicy
 }
 
 // hooks per client, for fast access.
 type hooks struct {
-	Other []ent.Hook
-	Todo  []ent.Hook
-	User  []ent.Hook
+	Event      []ent.Hook
+	Other      []ent.Hook
+	PurgeLease []ent.Hook
+	Session    []ent.Hook
+	Tag        []ent.Hook
+	Todo       []ent.Hook
+	User       []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -59,3 +68,19 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// LifecycleCallbacks configures the lifecycle callbacks of the soft-deletable types.
+func LifecycleCallbacks(l *Lifecycle) Option {
+	return func(c *config) {
+		c.lifecycle = l
+	}
+}
+
+// SoftDeletePolicy configures the rules authorizing the hard deletes, purges and
+// queries of soft-deleted rows of the soft-deletable types. Without it, all of
+// them are allowed.
+func SoftDeletePolicy(rules ...BypassRule) Option {
+	return func(c *config) {
+		c.bypass = rules
+	}
+}
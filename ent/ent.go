@@ -7,7 +7,11 @@ import (
 	"errors"
 	"fmt"
 
+	"entgo.io/bug/ent/event"
 	"entgo.io/bug/ent/other"
+	"entgo.io/bug/ent/purgelease"
+	"entgo.io/bug/ent/session"
+	"entgo.io/bug/ent/tag"
 	"entgo.io/bug/ent/todo"
 	"entgo.io/bug/ent/user"
 	"entgo.io/ent"
@@ -33,9 +37,13 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		other.Table: other.ValidColumn,
-		todo.Table:  todo.ValidColumn,
-		user.Table:  user.ValidColumn,
+		event.Table:      event.ValidColumn,
+		other.Table:      other.ValidColumn,
+		purgelease.Table: purgelease.ValidColumn,
+		session.Table:    session.ValidColumn,
+		tag.Table:        tag.ValidColumn,
+		todo.Table:       todo.ValidColumn,
+		user.Table:       user.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -0,0 +1,119 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/bug/ent/event"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Event is the model entity for the Event schema.
+type Event struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt int64 `json:"deleted_at,omitempty"`
+	// DeletedBatch holds the value of the "deleted_batch" field.
+	DeletedBatch string `json:"deleted_batch,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Event) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case event.FieldID, event.FieldDeletedAt:
+			values[i] = new(sql.NullInt64)
+		case event.FieldDeletedBatch, event.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Event", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Event fields.
+func (e *Event) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case event.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			e.ID = int(value.Int64)
+		case event.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				e.DeletedAt = value.Int64
+			}
+		case event.FieldDeletedBatch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_batch", values[i])
+			} else if value.Valid {
+				e.DeletedBatch = value.String
+			}
+		case event.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				e.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Event.
+// Note that you need to call Event.Unwrap() before calling this method if this Event
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Event) Update() *EventUpdateOne {
+	return (&EventClient{config: e.config}).UpdateOne(e)
+}
+
+// Unwrap unwraps the Event entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (e *Event) Unwrap() *Event {
+	tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Event is not a transactional entity")
+	}
+	e.config.driver = tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Event) String() string {
+	var builder strings.Builder
+	builder.WriteString("Event(")
+	builder.WriteString(fmt.Sprintf("id=%v", e.ID))
+	builder.WriteString(", deleted_at=")
+	builder.WriteString(fmt.Sprintf("%v", e.DeletedAt))
+	builder.WriteString(", deleted_batch=")
+	builder.WriteString(e.DeletedBatch)
+	builder.WriteString(", name=")
+	builder.WriteString(e.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Events is a parsable slice of Event.
+type Events []*Event
+
+func (e Events) config(cfg config) {
+	for _i := range e {
+		e[_i].config = cfg
+	}
+}
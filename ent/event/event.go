@@ -0,0 +1,52 @@
+// Code generated by entc, DO NOT EDIT.
+
+package event
+
+import (
+	"entgo.io/ent"
+)
+
+const (
+	// Label holds the string label denoting the event type in the database.
+	Label = "event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedBatch holds the string denoting the deleted_batch field in the database.
+	FieldDeletedBatch = "deleted_batch"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// Table holds the table name of the event in the database.
+	Table = "events"
+)
+
+// Columns holds all SQL columns for event fields.
+var Columns = []string{
+	FieldID,
+	FieldDeletedAt,
+	FieldDeletedBatch,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "entgo.io/bug/ent/runtime"
+//
+var (
+	Hooks [2]ent.Hook
+	// DefaultDeletedAt holds the default value on creation for the "deleted_at" field.
+	DefaultDeletedAt int64
+)
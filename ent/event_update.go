@@ -0,0 +1,441 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/bug/ent/event"
+	"entgo.io/bug/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (eu *EventUpdate) SetDeletedAt(i int64) *EventUpdate {
+	eu.mutation.ResetDeletedAt()
+	eu.mutation.SetDeletedAt(i)
+	return eu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableDeletedAt(i *int64) *EventUpdate {
+	if i != nil {
+		eu.SetDeletedAt(*i)
+	}
+	return eu
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (eu *EventUpdate) AddDeletedAt(i int64) *EventUpdate {
+	eu.mutation.AddDeletedAt(i)
+	return eu
+}
+
+// SetDeletedBatch sets the "deleted_batch" field.
+func (eu *EventUpdate) SetDeletedBatch(s string) *EventUpdate {
+	eu.mutation.SetDeletedBatch(s)
+	return eu
+}
+
+// SetNillableDeletedBatch sets the "deleted_batch" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableDeletedBatch(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetDeletedBatch(*s)
+	}
+	return eu
+}
+
+// ClearDeletedBatch clears the value of the "deleted_batch" field.
+func (eu *EventUpdate) ClearDeletedBatch() *EventUpdate {
+	eu.mutation.ClearDeletedBatch()
+	return eu
+}
+
+// SetName sets the "name" field.
+func (eu *EventUpdate) SetName(s string) *EventUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (eu *EventUpdate) Mutation() *EventMutation {
+	return eu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(eu.hooks) == 0 {
+		affected, err = eu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			eu.mutation = mutation
+			affected, err = eu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eu.hooks) - 1; i >= 0; i-- {
+			if eu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EventUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EventUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldDeletedAt,
+		})
+	}
+	if value, ok := eu.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldDeletedAt,
+		})
+	}
+	if value, ok := eu.mutation.DeletedBatch(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldDeletedBatch,
+		})
+	}
+	if eu.mutation.DeletedBatchCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: event.FieldDeletedBatch,
+		})
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+	}
+	deleted, err := eu.updateDeleted(ctx, TypeEvent, eu.mutation)
+	if err != nil {
+		return 0, err
+	}
+	if !deleted {
+		ps := _spec.Predicate
+		_spec.Predicate = func(s *sql.Selector) {
+			if ps != nil {
+				ps(s)
+			}
+			eventNotDeleted()(s)
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (euo *EventUpdateOne) SetDeletedAt(i int64) *EventUpdateOne {
+	euo.mutation.ResetDeletedAt()
+	euo.mutation.SetDeletedAt(i)
+	return euo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableDeletedAt(i *int64) *EventUpdateOne {
+	if i != nil {
+		euo.SetDeletedAt(*i)
+	}
+	return euo
+}
+
+// AddDeletedAt adds i to the "deleted_at" field.
+func (euo *EventUpdateOne) AddDeletedAt(i int64) *EventUpdateOne {
+	euo.mutation.AddDeletedAt(i)
+	return euo
+}
+
+// SetDeletedBatch sets the "deleted_batch" field.
+func (euo *EventUpdateOne) SetDeletedBatch(s string) *EventUpdateOne {
+	euo.mutation.SetDeletedBatch(s)
+	return euo
+}
+
+// SetNillableDeletedBatch sets the "deleted_batch" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableDeletedBatch(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetDeletedBatch(*s)
+	}
+	return euo
+}
+
+// ClearDeletedBatch clears the value of the "deleted_batch" field.
+func (euo *EventUpdateOne) ClearDeletedBatch() *EventUpdateOne {
+	euo.mutation.ClearDeletedBatch()
+	return euo
+}
+
+// SetName sets the "name" field.
+func (euo *EventUpdateOne) SetName(s string) *EventUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (euo *EventUpdateOne) Mutation() *EventMutation {
+	return euo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Event entity.
+func (euo *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	var (
+		err  error
+		node *Event
+	)
+	if len(euo.hooks) == 0 {
+		node, err = euo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			euo.mutation = mutation
+			node, err = euo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(euo.hooks) - 1; i >= 0; i-- {
+			if euo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = euo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, euo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Event.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for _, f := range fields {
+			if !event.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.DeletedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldDeletedAt,
+		})
+	}
+	if value, ok := euo.mutation.AddedDeletedAt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: event.FieldDeletedAt,
+		})
+	}
+	if value, ok := euo.mutation.DeletedBatch(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldDeletedBatch,
+		})
+	}
+	if euo.mutation.DeletedBatchCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: event.FieldDeletedBatch,
+		})
+	}
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+	}
+	deleted, err := euo.updateDeleted(ctx, TypeEvent, euo.mutation)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		deletedTime, set := euo.mutation.DeletedAt()
+		if set {
+			// Setting the soft-delete field does not allow editing a soft-deleted node.
+			if err := NewEventClient(euo.config).DeletedError(ctx, id); err != nil {
+				return nil, err
+			}
+		}
+		ps := _spec.Predicate
+		_spec.Predicate = func(s *sql.Selector) {
+			if ps != nil {
+				ps(s)
+			}
+			// The predicate applies to the SELECT statement loading the node after
+			// the update as well, which may have left it soft-deleted. Only the
+			// UPDATE statement, which selects no columns, is limited to the live node.
+			if set && len(s.SelectedColumns()) > 0 {
+				s.Where(sql.Or(sql.EQ(s.C(event.FieldDeletedAt), deletedTime), sql.ExprP(s.C("deleted_at")+" "+predicate.EventLiveCondition)))
+				return
+			}
+			eventNotDeleted()(s)
+		}
+	}
+	_node = &Event{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
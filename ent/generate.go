@@ -1,3 +1,3 @@
 package ent
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --template ./schema/softdelete.tmpl ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --template ./schema/softdelete.tmpl --template ./schema/query.tmpl ./schema
@@ -0,0 +1,90 @@
+// Code generated by entc, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+
+	"entgo.io/bug/ent"
+)
+
+// EventSoftDelete returns a Event mutator that makes the delete builder
+// stamp the deleted time on the matched rows instead of removing them, even
+// if the context requests hard deletes.
+func EventSoftDelete(next ent.Mutator) EventFunc {
+	return func(ctx context.Context, m *ent.EventMutation) (ent.Value, error) {
+		return next.Mutate(ent.NewSoftDeleteContext(ctx, m), m)
+	}
+}
+
+// SessionSoftDelete returns a Session mutator that makes the delete builder
+// stamp the deleted time on the matched rows instead of removing them, even
+// if the context requests hard deletes.
+func SessionSoftDelete(next ent.Mutator) SessionFunc {
+	return func(ctx context.Context, m *ent.SessionMutation) (ent.Value, error) {
+		return next.Mutate(ent.NewSoftDeleteContext(ctx, m), m)
+	}
+}
+
+// TagSoftDelete returns a Tag mutator that makes the delete builder
+// stamp the deleted time on the matched rows instead of removing them, even
+// if the context requests hard deletes.
+func TagSoftDelete(next ent.Mutator) TagFunc {
+	return func(ctx context.Context, m *ent.TagMutation) (ent.Value, error) {
+		return next.Mutate(ent.NewSoftDeleteContext(ctx, m), m)
+	}
+}
+
+// TodoSoftDelete returns a Todo mutator that makes the delete builder
+// stamp the deleted time on the matched rows instead of removing them, even
+// if the context requests hard deletes.
+func TodoSoftDelete(next ent.Mutator) TodoFunc {
+	return func(ctx context.Context, m *ent.TodoMutation) (ent.Value, error) {
+		return next.Mutate(ent.NewSoftDeleteContext(ctx, m), m)
+	}
+}
+
+// UserSoftDelete returns a User mutator that makes the delete builder
+// stamp the deleted time on the matched rows instead of removing them, even
+// if the context requests hard deletes.
+func UserSoftDelete(next ent.Mutator) UserFunc {
+	return func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+		return next.Mutate(ent.NewSoftDeleteContext(ctx, m), m)
+	}
+}
+
+// UpdateDeleted returns a mutator that reports the update-one mutations of
+// soft-deleted entities with a DeletedError in place of a NotFoundError.
+func UpdateDeleted(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		v, err := next.Mutate(ctx, m)
+		if ent.IsNotFound(err) {
+			if derr := ent.DeletedErrorOf(ctx, m); derr != nil {
+				return nil, derr
+			}
+		}
+		return v, err
+	})
+}
+
+// IsSoftDelete is a condition matching the delete mutations that soft-delete rows.
+func IsSoftDelete() Condition {
+	return func(ctx context.Context, m ent.Mutation) bool {
+		return ent.DeleteKindOf(ctx, m) == ent.DeleteKindSoft
+	}
+}
+
+// IsHardDelete is a condition matching the delete mutations that remove rows.
+func IsHardDelete() Condition {
+	return func(ctx context.Context, m ent.Mutation) bool {
+		return ent.DeleteKindOf(ctx, m) == ent.DeleteKindHard
+	}
+}
+
+// IsSoftDeleteUpdate is a condition matching the update mutations that stamp
+// rows on behalf of a soft delete.
+func IsSoftDeleteUpdate() Condition {
+	return func(ctx context.Context, m ent.Mutation) bool {
+		return ent.DeleteKindOf(ctx, m) == ent.DeleteKindSoftUpdate
+	}
+}
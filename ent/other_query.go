@@ -303,6 +303,9 @@ func (oq *OtherQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if err := oq.authorizeDeletedTime(ctx); err != nil {
+		return err
+	}
 	if oq.path != nil {
 		prev, err := oq.path(ctx)
 		if err != nil {
@@ -435,6 +438,18 @@ func (oq *OtherQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// authorizeDeletedTime authorizes the query of soft-deleted neighbors
+// matched by the edge predicates of the query, and returns a BypassDeniedError
+// if the soft-delete policy denies it.
+func (oq *OtherQuery) authorizeDeletedTime(ctx context.Context) error {
+	var types []string
+	return oq.authorizeDeletedTimeFilter(ctx, other.Table, func(s *sql.Selector) {
+		for _, p := range oq.predicates {
+			p(s)
+		}
+	}, types...)
+}
+
 // OtherGroupBy is the group-by builder for Other entities.
 type OtherGroupBy struct {
 	config
@@ -0,0 +1,249 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/bug/ent/purgelease"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PurgeLeaseCreate is the builder for creating a PurgeLease entity.
+type PurgeLeaseCreate struct {
+	config
+	mutation *PurgeLeaseMutation
+	hooks    []Hook
+}
+
+// SetHolder sets the "holder" field.
+func (plc *PurgeLeaseCreate) SetHolder(s string) *PurgeLeaseCreate {
+	plc.mutation.SetHolder(s)
+	return plc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (plc *PurgeLeaseCreate) SetExpiresAt(t time.Time) *PurgeLeaseCreate {
+	plc.mutation.SetExpiresAt(t)
+	return plc
+}
+
+// SetID sets the "id" field.
+func (plc *PurgeLeaseCreate) SetID(s string) *PurgeLeaseCreate {
+	plc.mutation.SetID(s)
+	return plc
+}
+
+// Mutation returns the PurgeLeaseMutation object of the builder.
+func (plc *PurgeLeaseCreate) Mutation() *PurgeLeaseMutation {
+	return plc.mutation
+}
+
+// Save creates the PurgeLease in the database.
+func (plc *PurgeLeaseCreate) Save(ctx context.Context) (*PurgeLease, error) {
+	var (
+		err  error
+		node *PurgeLease
+	)
+	if len(plc.hooks) == 0 {
+		if err = plc.check(); err != nil {
+			return nil, err
+		}
+		node, err = plc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PurgeLeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = plc.check(); err != nil {
+				return nil, err
+			}
+			plc.mutation = mutation
+			if node, err = plc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(plc.hooks) - 1; i >= 0; i-- {
+			if plc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, plc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (plc *PurgeLeaseCreate) SaveX(ctx context.Context) *PurgeLease {
+	v, err := plc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plc *PurgeLeaseCreate) Exec(ctx context.Context) error {
+	_, err := plc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plc *PurgeLeaseCreate) ExecX(ctx context.Context) {
+	if err := plc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (plc *PurgeLeaseCreate) check() error {
+	if _, ok := plc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`ent: missing required field "PurgeLease.holder"`)}
+	}
+	if _, ok := plc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PurgeLease.expires_at"`)}
+	}
+	return nil
+}
+
+func (plc *PurgeLeaseCreate) sqlSave(ctx context.Context) (*PurgeLease, error) {
+	_node, _spec := plc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, plc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected PurgeLease.ID type: %T", _spec.ID.Value)
+		}
+	}
+	return _node, nil
+}
+
+func (plc *PurgeLeaseCreate) createSpec() (*PurgeLease, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PurgeLease{config: plc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: purgelease.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: purgelease.FieldID,
+			},
+		}
+	)
+	if id, ok := plc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := plc.mutation.Holder(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purgelease.FieldHolder,
+		})
+		_node.Holder = value
+	}
+	if value, ok := plc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: purgelease.FieldExpiresAt,
+		})
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// PurgeLeaseCreateBulk is the builder for creating many PurgeLease entities in bulk.
+type PurgeLeaseCreateBulk struct {
+	config
+	builders []*PurgeLeaseCreate
+}
+
+// Save creates the PurgeLease entities in the database.
+func (plcb *PurgeLeaseCreateBulk) Save(ctx context.Context) ([]*PurgeLease, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(plcb.builders))
+	nodes := make([]*PurgeLease, len(plcb.builders))
+	mutators := make([]Mutator, len(plcb.builders))
+	for i := range plcb.builders {
+		func(i int, root context.Context) {
+			builder := plcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PurgeLeaseMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, plcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, plcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, plcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plcb *PurgeLeaseCreateBulk) SaveX(ctx context.Context) []*PurgeLease {
+	v, err := plcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (plcb *PurgeLeaseCreateBulk) Exec(ctx context.Context) error {
+	_, err := plcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plcb *PurgeLeaseCreateBulk) ExecX(ctx context.Context) {
+	if err := plcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
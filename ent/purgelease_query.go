@@ -0,0 +1,543 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/purgelease"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PurgeLeaseQuery is the builder for querying PurgeLease entities.
+type PurgeLeaseQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.PurgeLease
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PurgeLeaseQuery builder.
+func (plq *PurgeLeaseQuery) Where(ps ...predicate.PurgeLease) *PurgeLeaseQuery {
+	plq.predicates = append(plq.predicates, ps...)
+	return plq
+}
+
+// Limit adds a limit step to the query.
+func (plq *PurgeLeaseQuery) Limit(limit int) *PurgeLeaseQuery {
+	plq.limit = &limit
+	return plq
+}
+
+// Offset adds an offset step to the query.
+func (plq *PurgeLeaseQuery) Offset(offset int) *PurgeLeaseQuery {
+	plq.offset = &offset
+	return plq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (plq *PurgeLeaseQuery) Unique(unique bool) *PurgeLeaseQuery {
+	plq.unique = &unique
+	return plq
+}
+
+// Order adds an order step to the query.
+func (plq *PurgeLeaseQuery) Order(o ...OrderFunc) *PurgeLeaseQuery {
+	plq.order = append(plq.order, o...)
+	return plq
+}
+
+// First returns the first PurgeLease entity from the query.
+// Returns a *NotFoundError when no PurgeLease was found.
+func (plq *PurgeLeaseQuery) First(ctx context.Context) (*PurgeLease, error) {
+	nodes, err := plq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{purgelease.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) FirstX(ctx context.Context) *PurgeLease {
+	node, err := plq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PurgeLease ID from the query.
+// Returns a *NotFoundError when no PurgeLease ID was found.
+func (plq *PurgeLeaseQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = plq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{purgelease.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) FirstIDX(ctx context.Context) string {
+	id, err := plq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PurgeLease entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PurgeLease entity is found.
+// Returns a *NotFoundError when no PurgeLease entities are found.
+func (plq *PurgeLeaseQuery) Only(ctx context.Context) (*PurgeLease, error) {
+	nodes, err := plq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{purgelease.Label}
+	default:
+		return nil, &NotSingularError{purgelease.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) OnlyX(ctx context.Context) *PurgeLease {
+	node, err := plq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PurgeLease ID in the query.
+// Returns a *NotSingularError when more than one PurgeLease ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (plq *PurgeLeaseQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = plq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{purgelease.Label}
+	default:
+		err = &NotSingularError{purgelease.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) OnlyIDX(ctx context.Context) string {
+	id, err := plq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PurgeLeases.
+func (plq *PurgeLeaseQuery) All(ctx context.Context) ([]*PurgeLease, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return plq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) AllX(ctx context.Context) []*PurgeLease {
+	nodes, err := plq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PurgeLease IDs.
+func (plq *PurgeLeaseQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := plq.Select(purgelease.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) IDsX(ctx context.Context) []string {
+	ids, err := plq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (plq *PurgeLeaseQuery) Count(ctx context.Context) (int, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return plq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) CountX(ctx context.Context) int {
+	count, err := plq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (plq *PurgeLeaseQuery) Exist(ctx context.Context) (bool, error) {
+	if err := plq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return plq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (plq *PurgeLeaseQuery) ExistX(ctx context.Context) bool {
+	exist, err := plq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PurgeLeaseQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (plq *PurgeLeaseQuery) Clone() *PurgeLeaseQuery {
+	if plq == nil {
+		return nil
+	}
+	return &PurgeLeaseQuery{
+		config:     plq.config,
+		limit:      plq.limit,
+		offset:     plq.offset,
+		order:      append([]OrderFunc{}, plq.order...),
+		predicates: append([]predicate.PurgeLease{}, plq.predicates...),
+		// clone intermediate query.
+		sql:    plq.sql.Clone(),
+		path:   plq.path,
+		unique: plq.unique,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Holder string `json:"holder,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PurgeLease.Query().
+//		GroupBy(purgelease.FieldHolder).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (plq *PurgeLeaseQuery) GroupBy(field string, fields ...string) *PurgeLeaseGroupBy {
+	grbuild := &PurgeLeaseGroupBy{config: plq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := plq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return plq.sqlQuery(ctx), nil
+	}
+	grbuild.label = purgelease.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Holder string `json:"holder,omitempty"`
+//	}
+//
+//	client.PurgeLease.Query().
+//		Select(purgelease.FieldHolder).
+//		Scan(ctx, &v)
+//
+func (plq *PurgeLeaseQuery) Select(fields ...string) *PurgeLeaseSelect {
+	plq.fields = append(plq.fields, fields...)
+	selbuild := &PurgeLeaseSelect{PurgeLeaseQuery: plq}
+	selbuild.label = purgelease.Label
+	selbuild.flds, selbuild.scan = &plq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (plq *PurgeLeaseQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range plq.fields {
+		if !purgelease.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if err := plq.authorizeDeletedTime(ctx); err != nil {
+		return err
+	}
+	if plq.path != nil {
+		prev, err := plq.path(ctx)
+		if err != nil {
+			return err
+		}
+		plq.sql = prev
+	}
+	return nil
+}
+
+func (plq *PurgeLeaseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PurgeLease, error) {
+	var (
+		nodes = []*PurgeLease{}
+		_spec = plq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*PurgeLease).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &PurgeLease{config: plq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, plq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (plq *PurgeLeaseQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := plq.querySpec()
+	_spec.Node.Columns = plq.fields
+	if len(plq.fields) > 0 {
+		_spec.Unique = plq.unique != nil && *plq.unique
+	}
+	return sqlgraph.CountNodes(ctx, plq.driver, _spec)
+}
+
+func (plq *PurgeLeaseQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := plq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (plq *PurgeLeaseQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   purgelease.Table,
+			Columns: purgelease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: purgelease.FieldID,
+			},
+		},
+		From:   plq.sql,
+		Unique: true,
+	}
+	if unique := plq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := plq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, purgelease.FieldID)
+		for i := range fields {
+			if fields[i] != purgelease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := plq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := plq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := plq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := plq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (plq *PurgeLeaseQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(plq.driver.Dialect())
+	t1 := builder.Table(purgelease.Table)
+	columns := plq.fields
+	if len(columns) == 0 {
+		columns = purgelease.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if plq.sql != nil {
+		selector = plq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if plq.unique != nil && *plq.unique {
+		selector.Distinct()
+	}
+	for _, p := range plq.predicates {
+		p(selector)
+	}
+	for _, p := range plq.order {
+		p(selector)
+	}
+	if offset := plq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := plq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// authorizeDeletedTime authorizes the query of soft-deleted neighbors
+// matched by the edge predicates of the query, and returns a BypassDeniedError
+// if the soft-delete policy denies it.
+func (plq *PurgeLeaseQuery) authorizeDeletedTime(ctx context.Context) error {
+	var types []string
+	return plq.authorizeDeletedTimeFilter(ctx, purgelease.Table, func(s *sql.Selector) {
+		for _, p := range plq.predicates {
+			p(s)
+		}
+	}, types...)
+}
+
+// PurgeLeaseGroupBy is the group-by builder for PurgeLease entities.
+type PurgeLeaseGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (plgb *PurgeLeaseGroupBy) Aggregate(fns ...AggregateFunc) *PurgeLeaseGroupBy {
+	plgb.fns = append(plgb.fns, fns...)
+	return plgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (plgb *PurgeLeaseGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := plgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	plgb.sql = query
+	return plgb.sqlScan(ctx, v)
+}
+
+func (plgb *PurgeLeaseGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range plgb.fields {
+		if !purgelease.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := plgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := plgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (plgb *PurgeLeaseGroupBy) sqlQuery() *sql.Selector {
+	selector := plgb.sql.Select()
+	aggregation := make([]string, 0, len(plgb.fns))
+	for _, fn := range plgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(plgb.fields)+len(plgb.fns))
+		for _, f := range plgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(plgb.fields...)...)
+}
+
+// PurgeLeaseSelect is the builder for selecting fields of PurgeLease entities.
+type PurgeLeaseSelect struct {
+	*PurgeLeaseQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pls *PurgeLeaseSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := pls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	pls.sql = pls.PurgeLeaseQuery.sqlQuery(ctx)
+	return pls.sqlScan(ctx, v)
+}
+
+func (pls *PurgeLeaseSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := pls.sql.Query()
+	if err := pls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,292 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/purgelease"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PurgeLeaseUpdate is the builder for updating PurgeLease entities.
+type PurgeLeaseUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PurgeLeaseMutation
+}
+
+// Where appends a list predicates to the PurgeLeaseUpdate builder.
+func (plu *PurgeLeaseUpdate) Where(ps ...predicate.PurgeLease) *PurgeLeaseUpdate {
+	plu.mutation.Where(ps...)
+	return plu
+}
+
+// SetHolder sets the "holder" field.
+func (plu *PurgeLeaseUpdate) SetHolder(s string) *PurgeLeaseUpdate {
+	plu.mutation.SetHolder(s)
+	return plu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (plu *PurgeLeaseUpdate) SetExpiresAt(t time.Time) *PurgeLeaseUpdate {
+	plu.mutation.SetExpiresAt(t)
+	return plu
+}
+
+// Mutation returns the PurgeLeaseMutation object of the builder.
+func (plu *PurgeLeaseUpdate) Mutation() *PurgeLeaseMutation {
+	return plu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (plu *PurgeLeaseUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(plu.hooks) == 0 {
+		affected, err = plu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PurgeLeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			plu.mutation = mutation
+			affected, err = plu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(plu.hooks) - 1; i >= 0; i-- {
+			if plu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = plu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, plu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (plu *PurgeLeaseUpdate) SaveX(ctx context.Context) int {
+	affected, err := plu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (plu *PurgeLeaseUpdate) Exec(ctx context.Context) error {
+	_, err := plu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (plu *PurgeLeaseUpdate) ExecX(ctx context.Context) {
+	if err := plu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (plu *PurgeLeaseUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   purgelease.Table,
+			Columns: purgelease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: purgelease.FieldID,
+			},
+		},
+	}
+	if ps := plu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := plu.mutation.Holder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purgelease.FieldHolder,
+		})
+	}
+	if value, ok := plu.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: purgelease.FieldExpiresAt,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, plu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{purgelease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PurgeLeaseUpdateOne is the builder for updating a single PurgeLease entity.
+type PurgeLeaseUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PurgeLeaseMutation
+}
+
+// SetHolder sets the "holder" field.
+func (pluo *PurgeLeaseUpdateOne) SetHolder(s string) *PurgeLeaseUpdateOne {
+	pluo.mutation.SetHolder(s)
+	return pluo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pluo *PurgeLeaseUpdateOne) SetExpiresAt(t time.Time) *PurgeLeaseUpdateOne {
+	pluo.mutation.SetExpiresAt(t)
+	return pluo
+}
+
+// Mutation returns the PurgeLeaseMutation object of the builder.
+func (pluo *PurgeLeaseUpdateOne) Mutation() *PurgeLeaseMutation {
+	return pluo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pluo *PurgeLeaseUpdateOne) Select(field string, fields ...string) *PurgeLeaseUpdateOne {
+	pluo.fields = append([]string{field}, fields...)
+	return pluo
+}
+
+// Save executes the query and returns the updated PurgeLease entity.
+func (pluo *PurgeLeaseUpdateOne) Save(ctx context.Context) (*PurgeLease, error) {
+	var (
+		err  error
+		node *PurgeLease
+	)
+	if len(pluo.hooks) == 0 {
+		node, err = pluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*PurgeLeaseMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			pluo.mutation = mutation
+			node, err = pluo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pluo.hooks) - 1; i >= 0; i-- {
+			if pluo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = pluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pluo *PurgeLeaseUpdateOne) SaveX(ctx context.Context) *PurgeLease {
+	node, err := pluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pluo *PurgeLeaseUpdateOne) Exec(ctx context.Context) error {
+	_, err := pluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pluo *PurgeLeaseUpdateOne) ExecX(ctx context.Context) {
+	if err := pluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (pluo *PurgeLeaseUpdateOne) sqlSave(ctx context.Context) (_node *PurgeLease, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   purgelease.Table,
+			Columns: purgelease.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: purgelease.FieldID,
+			},
+		},
+	}
+	id, ok := pluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PurgeLease.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, purgelease.FieldID)
+		for _, f := range fields {
+			if !purgelease.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != purgelease.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pluo.mutation.Holder(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: purgelease.FieldHolder,
+		})
+	}
+	if value, ok := pluo.mutation.ExpiresAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: purgelease.FieldExpiresAt,
+		})
+	}
+	_node = &PurgeLease{config: pluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{purgelease.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
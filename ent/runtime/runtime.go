@@ -3,21 +3,69 @@
 package runtime
 
 import (
+	"time"
+
+	"entgo.io/bug/ent/event"
 	"entgo.io/bug/ent/schema"
+	"entgo.io/bug/ent/session"
+	"entgo.io/bug/ent/tag"
 	"entgo.io/bug/ent/todo"
 	"entgo.io/bug/ent/user"
+	"github.com/google/uuid"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	eventMixin := schema.Event{}.Mixin()
+	eventMixinHooks0 := eventMixin[0].Hooks()
+	event.Hooks[0] = eventMixinHooks0[0]
+	event.Hooks[1] = eventMixinHooks0[1]
+	eventMixinFields0 := eventMixin[0].Fields()
+	_ = eventMixinFields0
+	eventFields := schema.Event{}.Fields()
+	_ = eventFields
+	// eventDescDeletedAt is the schema descriptor for deleted_at field.
+	eventDescDeletedAt := eventMixinFields0[0].Descriptor()
+	// event.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	event.DefaultDeletedAt = eventDescDeletedAt.Default.(int64)
+	sessionMixin := schema.Session{}.Mixin()
+	sessionMixinHooks0 := sessionMixin[0].Hooks()
+	session.Hooks[0] = sessionMixinHooks0[0]
+	session.Hooks[1] = sessionMixinHooks0[1]
+	sessionMixinFields0 := sessionMixin[0].Fields()
+	_ = sessionMixinFields0
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescDeletedAt is the schema descriptor for deleted_at field.
+	sessionDescDeletedAt := sessionMixinFields0[0].Descriptor()
+	// session.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	session.DefaultDeletedAt = sessionDescDeletedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	tagMixin := schema.Tag{}.Mixin()
+	tagMixinHooks0 := tagMixin[0].Hooks()
+	tag.Hooks[0] = tagMixinHooks0[0]
+	tag.Hooks[1] = tagMixinHooks0[1]
+	tagMixinFields0 := tagMixin[0].Fields()
+	_ = tagMixinFields0
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescIsDeleted is the schema descriptor for is_deleted field.
+	tagDescIsDeleted := tagMixinFields0[0].Descriptor()
+	// tag.DefaultIsDeleted holds the default value on creation for the is_deleted field.
+	tag.DefaultIsDeleted = tagDescIsDeleted.Default.(bool)
 	todoMixin := schema.Todo{}.Mixin()
 	todoMixinHooks0 := todoMixin[0].Hooks()
 	todo.Hooks[0] = todoMixinHooks0[0]
+	todo.Hooks[1] = todoMixinHooks0[1]
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks0 := userMixin[0].Hooks()
 	user.Hooks[0] = userMixinHooks0[0]
+	user.Hooks[1] = userMixinHooks0[1]
 }
 
 const (
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// PurgeLeaseAnnotation marks the type holding the leases of the purge worker.
+type PurgeLeaseAnnotation struct {
+	OK bool
+}
+
+func (PurgeLeaseAnnotation) Name() string {
+	return "PurgeLease"
+}
+
+// Lease makes its schema the lease table of the generated PurgeWorker, which
+// is generated only if a schema uses the mixin. At most one schema may use it.
+type Lease struct {
+	mixin.Schema
+}
+
+func (Lease) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Immutable(),
+		field.String("holder"),
+		field.Time("expires_at"),
+	}
+}
+
+func (Lease) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		PurgeLeaseAnnotation{OK: true},
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+)
+
+// PurgeLease holds the schema definition for the PurgeLease entity.
+// Its rows are the leases that let a single replica run the purge worker at a time.
+type PurgeLease struct {
+	ent.Schema
+}
+
+// Mixin of the PurgeLease.
+func (PurgeLease) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Lease{},
+	}
+}
+
+// Fields of the PurgeLease.
+func (PurgeLease) Fields() []ent.Field {
+	return nil
+}
+
+// Edges of the PurgeLease.
+func (PurgeLease) Edges() []ent.Edge {
+	return nil
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	entp "entgo.io/bug/ent"
@@ -9,70 +10,249 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 )
 
+// DeletedTimeStrategy defines how the soft-delete field is stored.
+type DeletedTimeStrategy string
+
+const (
+	// DeletedTimeNullable stores the deletion time in a nullable timestamp. NULL marks live rows.
+	DeletedTimeNullable DeletedTimeStrategy = "nullable"
+	// DeletedTimeFlag stores a boolean flag. false marks live rows.
+	DeletedTimeFlag DeletedTimeStrategy = "flag"
+	// DeletedTimeEpoch stores the deletion time as unix seconds. 0 marks live rows.
+	DeletedTimeEpoch DeletedTimeStrategy = "epoch"
+	// DeletedTimeSentinel stores the deletion time in a non-null timestamp. The
+	// smallest value a MySQL TIMESTAMP column accepts, 1970-01-01 00:00:01 UTC, marks live rows.
+	DeletedTimeSentinel DeletedTimeStrategy = "sentinel"
+)
+
+// sentinelDeletedUnix is the unix time of the soft-delete field of live rows
+// stored with the DeletedTimeSentinel strategy.
+const sentinelDeletedUnix = 1
+
 type DeletedTimeAnnotation struct {
-	OK bool
+	OK        bool
+	Field     string
+	Strategy  DeletedTimeStrategy
+	Audit     bool
+	Retention time.Duration
+	// LiveBefore is the date that the soft-delete field of the live rows stored with
+	// the DeletedTimeSentinel strategy precedes, the day after their sentinel. It does
+	// not depend on the time zone the database converts the sentinel to.
+	LiveBefore string
 }
 
 func (d DeletedTimeAnnotation) Name() string {
 	return "DeletedTime"
 }
 
+// SoftDeleteAction defines what happens to the neighbors of an edge
+// when the entities on its side are soft-deleted.
+type SoftDeleteAction string
+
+const (
+	// SoftDeleteCascade soft-deletes the neighbors along with the entity.
+	SoftDeleteCascade SoftDeleteAction = "cascade"
+	// SoftDeleteRestrict fails the soft delete while the entity has live neighbors.
+	SoftDeleteRestrict SoftDeleteAction = "restrict"
+	// SoftDeleteSetNull detaches the neighbors from the entity. The edge must be optional.
+	SoftDeleteSetNull SoftDeleteAction = "set_null"
+	// SoftDeleteNoAction leaves the neighbors untouched.
+	SoftDeleteNoAction SoftDeleteAction = "no_action"
+)
+
+// DeletedTimeEdgeAnnotation configures the soft delete of the neighbors of an edge.
+// The neighbors of a cascading edge must use the DeletedTime mixin as well.
+type DeletedTimeEdgeAnnotation struct {
+	Action SoftDeleteAction
+}
+
+func (DeletedTimeEdgeAnnotation) Name() string {
+	return "DeletedTimeEdge"
+}
+
 type DeletedTime struct {
 	mixin.Schema
+	// Field is the name of the soft-delete field. Defaults to "deleted_time".
+	Field string
+	// Strategy defines how the soft-delete field is stored. Defaults to DeletedTimeNullable.
+	Strategy DeletedTimeStrategy
+	// Audit adds the "deleted_by" and "deleted_reason" fields, recorded on soft delete.
+	Audit bool
+	// DeletedBy resolves the actor recorded on soft deletes whose context
+	// has no actor set with WithDeletedBy. Used only when Audit is set.
+	DeletedBy func(context.Context) (string, error)
+	// Retention is how long soft-deleted rows are kept before the purge runner
+	// removes them. Zero means the type declares no retention policy.
+	Retention time.Duration
+}
+
+func (d DeletedTime) Fields() []ent.Field {
+	var fields []ent.Field
+	switch name := d.field(); d.strategy() {
+	case DeletedTimeFlag:
+		fields = append(fields, field.Bool(name).Default(false))
+	case DeletedTimeEpoch:
+		fields = append(fields, field.Int64(name).Default(0))
+	case DeletedTimeSentinel:
+		fields = append(fields, field.Time(name).Default(func() time.Time {
+			return time.Unix(sentinelDeletedUnix, 0).UTC()
+		}))
+	default:
+		fields = append(fields, field.Time(name).Optional())
+	}
+	// deleted_batch identifies the soft-delete operation, including its cascades,
+	// that deleted the row. It allows restoring the rows of an operation as a unit.
+	fields = append(fields, field.String("deleted_batch").Optional())
+	if d.Audit {
+		fields = append(fields,
+			field.String("deleted_by").Optional(),
+			field.String("deleted_reason").Optional(),
+		)
+	}
+	return fields
+}
+
+// Indexes adds an index on the soft-delete field, used by the filters of live rows and purges.
+func (d DeletedTime) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields(d.field()),
+	}
+}
+
+// AppendIndexes appends the soft-delete field to the columns of the given
+// non-unique indexes, tuning them for lookups of live rows. Unique indexes
+// are returned as is, since soft-deleted rows would no longer conflict with
+// each other in them (see migrate.WithLiveIndexes for these).
+//
+// The indexes can not include edges, since ent places their columns after the
+// fields, and so after the soft-delete field. Declare the foreign keys as edge
+// fields and index these fields instead.
+func (d DeletedTime) AppendIndexes(indexes ...ent.Index) []ent.Index {
+	appended := make([]ent.Index, 0, len(indexes))
+	for _, idx := range indexes {
+		desc := idx.Descriptor()
+		if desc.Unique {
+			appended = append(appended, idx)
+			continue
+		}
+		if len(desc.Edges) > 0 {
+			panic(fmt.Sprintf("softdelete: the soft-delete field can not be appended to the index of edges %q, index their edge fields instead", desc.Edges))
+		}
+		fields := append(append([]string(nil), desc.Fields...), d.field())
+		b := index.Fields(fields...).
+			Annotations(desc.Annotations...)
+		if desc.StorageKey != "" {
+			b.StorageKey(desc.StorageKey)
+		}
+		appended = append(appended, b)
+	}
+	return appended
 }
 
-func (DeletedTime) Fields() []ent.Field {
-	return []ent.Field{
-		field.Time("deleted_time").Optional(),
+func (d DeletedTime) Annotations() []schema.Annotation {
+	a := DeletedTimeAnnotation{
+		OK:        true,
+		Field:     d.field(),
+		Strategy:  d.strategy(),
+		Audit:     d.Audit,
+		Retention: d.Retention,
 	}
+	if a.Strategy == DeletedTimeSentinel {
+		a.LiveBefore = time.Unix(sentinelDeletedUnix, 0).UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	}
+	return []schema.Annotation{a}
 }
 
-func (DeletedTime) Annotations() []schema.Annotation {
-	return []schema.Annotation{
-		DeletedTimeAnnotation{
-			OK: true,
-		},
+func (d DeletedTime) field() string {
+	if d.Field == "" {
+		return "deleted_time"
 	}
+	return d.Field
 }
 
-type skipDeletedTimeHook struct{}
+func (d DeletedTime) strategy() DeletedTimeStrategy {
+	if d.Strategy == "" {
+		return DeletedTimeNullable
+	}
+	return d.Strategy
+}
 
+// WithSkipDeletedTimeHook returns a context that makes deletes remove
+// the matched rows instead of soft-deleting them. The context applies to
+// all deletes executed with it, including the ones of hooks and callbacks.
+// Prefer the HardDelete builders of the clients, which bypass the soft
+// delete for a single operation. Either way, the hard deletes are subject
+// to the SoftDeletePolicy of the client.
 func WithSkipDeletedTimeHook(ctx context.Context) context.Context {
-	return context.WithValue(ctx, skipDeletedTimeHook{}, true)
+	return entp.NewHardDeleteContext(ctx)
+}
+
+// WithRestampDeletedTime returns a context that makes soft deletes overwrite
+// the deleted time of rows that were already soft-deleted.
+func WithRestampDeletedTime(ctx context.Context) context.Context {
+	return entp.NewRestampDeletedTimeContext(ctx)
+}
+
+// WithUpdateDeleted returns a context that allows update builders of
+// soft-deletable types to modify soft-deleted rows, for administrative edits.
+func WithUpdateDeleted(ctx context.Context) context.Context {
+	return entp.NewUpdateDeletedContext(ctx)
+}
+
+// WithIncludeDeleted returns a context that makes queries of soft-deletable
+// types return soft-deleted rows alongside the live ones.
+func WithIncludeDeleted(ctx context.Context) context.Context {
+	return entp.NewDeletedTimeFilterContext(ctx, entp.DeletedTimeInclude)
+}
+
+// WithOnlyDeleted returns a context that makes queries of soft-deletable
+// types return only the soft-deleted rows.
+func WithOnlyDeleted(ctx context.Context) context.Context {
+	return entp.NewDeletedTimeFilterContext(ctx, entp.DeletedTimeOnly)
 }
 
-func (DeletedTime) Hooks() []ent.Hook {
+// WithDeletedBy returns a context that records the given actor
+// on the rows it soft-deletes.
+func WithDeletedBy(ctx context.Context, actor string) context.Context {
+	info := entp.DeletionInfoFromContext(ctx)
+	info.By = actor
+	return entp.NewDeletionInfoContext(ctx, info)
+}
+
+// WithDeletedReason returns a context that records the given reason
+// on the rows it soft-deletes.
+func WithDeletedReason(ctx context.Context, reason string) context.Context {
+	info := entp.DeletionInfoFromContext(ctx)
+	info.Reason = reason
+	return entp.NewDeletionInfoContext(ctx, info)
+}
+
+// Hooks of the mixin. The generated delete builders of the soft-deletable types
+// stamp the deleted time and authorize the hard deletes by themselves, so the
+// delete hook only resolves the actor of the soft deletes.
+func (d DeletedTime) Hooks() []ent.Hook {
 	return []ent.Hook{
 		hook.On(func(next ent.Mutator) ent.Mutator {
 			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
-				_, skipDeletedTimeHook := ctx.Value(skipDeletedTimeHook{}).(bool)
-				if skipDeletedTimeHook {
+				if entp.DeleteKindOf(ctx, m) != entp.DeleteKindSoft {
 					return next.Mutate(ctx, m)
 				}
-
-				if idc, ok := m.(interface {
-					IDs(ctx context.Context) ([]int, error)
-					Client() *entp.Client
-				}); ok {
-					ids, err := idc.IDs(ctx)
+				if d.Audit && d.DeletedBy != nil && entp.DeletionInfoFromContext(ctx).By == "" {
+					actor, err := d.DeletedBy(ctx)
 					if err != nil {
 						return nil, err
 					}
-
-					err = entp.SetDeletedTimeForType(ctx, idc.Client(), m.Type(), time.Now(), ids)
-					if err != nil {
-						return nil, err
-					}
-
-					return len(ids), nil
+					ctx = WithDeletedBy(ctx, actor)
 				}
 
 				return next.Mutate(ctx, m)
 			})
 		}, ent.OpDeleteOne|ent.OpDelete,
 		),
+		hook.On(hook.UpdateDeleted, ent.OpUpdateOne),
 	}
 }
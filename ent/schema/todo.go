@@ -1,10 +1,19 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
+// todoDeletedTime is the soft-delete mixin of the Todo, shared with its indexes.
+var todoDeletedTime = DeletedTime{
+	Retention: 7 * 24 * time.Hour,
+}
+
 // Todo holds the schema definition for the Todo entity.
 type Todo struct {
 	ent.Schema
@@ -14,17 +23,38 @@ type Todo struct {
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("name"),
+		field.Int("owner_id").
+			Optional().
+			StorageKey("user_todos"),
 	}
 }
 
 // Edges of the Todo.
 func (Todo) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("todos").
+			Field("owner_id").
+			Unique(),
+		edge.To("children", Todo.Type).
+			Annotations(DeletedTimeEdgeAnnotation{Action: SoftDeleteCascade}).
+			From("parent").
+			Unique(),
+		edge.To("tags", Tag.Type).
+			Annotations(DeletedTimeEdgeAnnotation{Action: SoftDeleteSetNull}),
+	}
+}
+
+// Indexes of the Todo.
+func (Todo) Indexes() []ent.Index {
+	return todoDeletedTime.AppendIndexes(
+		index.Fields("owner_id"),
+	)
 }
 
 // Mixin of the Todo.
 func (Todo) Mixin() []ent.Mixin {
 	return []ent.Mixin{
-		DeletedTime{},
+		todoDeletedTime,
 	}
 }
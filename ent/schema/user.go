@@ -1,7 +1,10 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -14,18 +17,27 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("age"),
-		field.String("name"),
+		field.String("name").
+			Unique(),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("todos", Todo.Type).
+			Annotations(DeletedTimeEdgeAnnotation{Action: SoftDeleteCascade}),
+		edge.To("sessions", Session.Type).
+			Annotations(DeletedTimeEdgeAnnotation{Action: SoftDeleteRestrict}),
+	}
 }
 
 // Mixin of the User.
 func (User) Mixin() []ent.Mixin {
 	return []ent.Mixin{
-		DeletedTime{},
+		DeletedTime{
+			Audit:     true,
+			Retention: 90 * 24 * time.Hour,
+		},
 	}
 }
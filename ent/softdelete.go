@@ -4,21 +4,3139 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/bug/ent/event"
+	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/purgelease"
+	"entgo.io/bug/ent/session"
+	"entgo.io/bug/ent/tag"
 	"entgo.io/bug/ent/todo"
 	"entgo.io/bug/ent/user"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/privacy"
+	"github.com/google/uuid"
 )
 
-func SetDeletedTimeForType(ctx context.Context, c *Client, typ string, t time.Time, ids []int) error {
+// RestoreBatch restores the rows of all soft-deletable types that were deleted
+// by the given deletion batch, and returns how many of them were restored.
+func RestoreBatch(ctx context.Context, c *Client, batch string) (int, error) {
+	return softDeleteTx(ctx, c.config, func(cfg config) (int, error) {
+		var total int
+		for _, r := range []interface {
+			Exec(context.Context) (int, error)
+		}{
+			NewEventClient(cfg).Restore().Where(event.DeletedBatch(batch)),
+			NewSessionClient(cfg).Restore().Where(session.DeletedBatch(batch)),
+			NewTagClient(cfg).Restore().Where(tag.DeletedBatch(batch)),
+			NewTodoClient(cfg).Restore().Where(todo.DeletedBatch(batch)),
+			NewUserClient(cfg).Restore().Where(user.DeletedBatch(batch)),
+		} {
+			n, err := r.Exec(ctx)
+			if err != nil {
+				return 0, err
+			}
+			total += n
+		}
+		return total, nil
+	})
+}
+
+// RestoreBatchForType restores the rows that were deleted by the deletion batch
+// of the soft-deleted entity of the given type and id. The id must be of the ID
+// type of the given type (e.g. int).
+func RestoreBatchForType(ctx context.Context, c *Client, typ string, id interface{}) (int, error) {
+	var batch string
+	switch typ {
+	case TypeEvent:
+		v, ok := id.(int)
+		if !ok {
+			return 0, &InvalidIDError{Type: typ, ID: id, Expected: "int"}
+		}
+		e, err := c.Event.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(event.ID(v)).Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		batch = e.DeletedBatch
+	case TypeSession:
+		v, ok := id.(uuid.UUID)
+		if !ok {
+			return 0, &InvalidIDError{Type: typ, ID: id, Expected: "uuid.UUID"}
+		}
+		s, err := c.Session.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(session.ID(v)).Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		batch = s.DeletedBatch
+	case TypeTag:
+		v, ok := id.(int)
+		if !ok {
+			return 0, &InvalidIDError{Type: typ, ID: id, Expected: "int"}
+		}
+		t, err := c.Tag.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(tag.ID(v)).Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		batch = t.DeletedBatch
+	case TypeTodo:
+		v, ok := id.(int)
+		if !ok {
+			return 0, &InvalidIDError{Type: typ, ID: id, Expected: "int"}
+		}
+		t, err := c.Todo.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(todo.ID(v)).Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		batch = t.DeletedBatch
+	case TypeUser:
+		v, ok := id.(int)
+		if !ok {
+			return 0, &InvalidIDError{Type: typ, ID: id, Expected: "int"}
+		}
+		u, err := c.User.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(user.ID(v)).Only(ctx)
+		if err != nil {
+			return 0, err
+		}
+		batch = u.DeletedBatch
+	default:
+		return 0, &UnsupportedTypeError{Type: typ}
+	}
+	if batch == "" {
+		return 0, &NoDeletionBatchError{Type: typ, ID: id}
+	}
+	return RestoreBatch(ctx, c, batch)
+}
+
+// DefaultPurgeBatchSize is the default maximum number of rows removed by a single purge statement.
+const DefaultPurgeBatchSize = 1000
+
+// PurgeOptions configures the permanent removal of soft-deleted rows.
+type PurgeOptions struct {
+	// Before is the cutoff of the purge. Only rows soft-deleted before it are removed.
+	Before time.Time
+	// BatchSize is the maximum number of rows removed by a single statement.
+	// Defaults to DefaultPurgeBatchSize.
+	BatchSize int
+	// Types limits the purge to the given types. Defaults to all soft-deletable
+	// types that store their deletion time.
+	Types []string
+	// RowsPerSecond limits the rate at which rows are removed. Zero means no limit.
+	RowsPerSecond int
+	// Force makes PurgeExpired purge the types that declare no retention policy.
+	Force bool
+}
+
+// Purge permanently removes the rows that were soft-deleted before the cutoff
+// of the options, and returns how many rows were removed per type.
+func (c *Client) Purge(ctx context.Context, opts PurgeOptions) (map[string]int, error) {
+	types := opts.Types
+	if len(types) == 0 {
+		types = []string{
+			TypeEvent,
+			TypeSession,
+			TypeTodo,
+			TypeUser,
+		}
+	}
+	counts := make(map[string]int, len(types))
+	for _, typ := range types {
+		var purge interface {
+			Exec(context.Context) (int, error)
+		}
+		switch typ {
+		case TypeEvent:
+			purge = c.Event.Purge().Before(opts.Before).BatchSize(opts.BatchSize).RowsPerSecond(opts.RowsPerSecond)
+		case TypeSession:
+			purge = c.Session.Purge().Before(opts.Before).BatchSize(opts.BatchSize).RowsPerSecond(opts.RowsPerSecond)
+		case TypeTag:
+			purge = c.Tag.Purge().Before(opts.Before).BatchSize(opts.BatchSize).RowsPerSecond(opts.RowsPerSecond)
+		case TypeTodo:
+			purge = c.Todo.Purge().Before(opts.Before).BatchSize(opts.BatchSize).RowsPerSecond(opts.RowsPerSecond)
+		case TypeUser:
+			purge = c.User.Purge().Before(opts.Before).BatchSize(opts.BatchSize).RowsPerSecond(opts.RowsPerSecond)
+		default:
+			return counts, &UnsupportedTypeError{Type: typ}
+		}
+		n, err := purge.Exec(ctx)
+		counts[typ] = n
+		if err != nil {
+			return counts, err
+		}
+	}
+	return counts, nil
+}
+
+// RetentionPolicy returns the retention declared in the schema of the given type,
+// and reports if it declares one. PurgeExpired removes the rows soft-deleted for
+// longer than it.
+func RetentionPolicy(typ string) (time.Duration, bool) {
 	switch typ {
-	case "Todo":
-		return c.Todo.Update().Where(todo.IDIn(ids...)).SetDeletedTime(t).Exec(ctx)
-	case "User":
-		return c.User.Update().Where(user.IDIn(ids...)).SetDeletedTime(t).Exec(ctx)
+	case TypeTodo:
+		return time.Duration(604800000000000), true
+	case TypeUser:
+		return time.Duration(7776000000000000), true
+	}
+	return 0, false
+}
+
+// PurgeExpired permanently removes the rows that were soft-deleted for longer than
+// the retention policy of their type, and returns how many rows were removed per
+// type. By default, all types with a retention policy are purged. Types in the
+// options that declare no policy are refused, unless the purge is forced, in which
+// case the Before cutoff of the options applies to them.
+func (c *Client) PurgeExpired(ctx context.Context, opts PurgeOptions) (map[string]int, error) {
+	types := opts.Types
+	if len(types) == 0 {
+		types = []string{
+			TypeTodo,
+			TypeUser,
+		}
+	}
+	for _, typ := range types {
+		if _, ok := RetentionPolicy(typ); !ok && !opts.Force {
+			return nil, &NoRetentionPolicyError{Type: typ}
+		}
+	}
+	now := time.Now()
+	counts := make(map[string]int, len(types))
+	for _, typ := range types {
+		o := opts
+		o.Types = []string{typ}
+		if d, ok := RetentionPolicy(typ); ok {
+			o.Before = now.Add(-d)
+		}
+		n, err := c.Purge(ctx, o)
+		counts[typ] = n[typ]
+		if err != nil {
+			return counts, err
+		}
+	}
+	return counts, nil
+}
+
+// DefaultPurgeInterval is the default interval between the runs of a PurgeWorker.
+const DefaultPurgeInterval = time.Hour
+
+// DefaultPurgeBackoff is the default delay before retrying a failed run of a PurgeWorker.
+const DefaultPurgeBackoff = time.Second
+
+// DefaultPurgeLease is the default name of the lease held by a PurgeWorker.
+const DefaultPurgeLease = "purge"
+
+// purgeReleaseTimeout bounds the release of the lease when a PurgeWorker stops.
+const purgeReleaseTimeout = 5 * time.Second
+
+// PurgeWorkerConfig configures a PurgeWorker.
+type PurgeWorkerConfig struct {
+	// PurgeOptions are the options of the PurgeExpired runs.
+	PurgeOptions
+	// Interval is the interval between runs. Defaults to DefaultPurgeInterval.
+	Interval time.Duration
+	// Backoff is the delay before retrying a failed run. It doubles on each
+	// consecutive failure, up to the Interval. Defaults to DefaultPurgeBackoff.
+	Backoff time.Duration
+	// Lease is the name of the lease. Workers sharing a lease never run at the
+	// same time. Defaults to DefaultPurgeLease.
+	Lease string
+	// Holder identifies the worker as the holder of the lease. Defaults to a random UUID.
+	Holder string
+	// LeaseTTL is how long the lease is held after a run started.
+	// It should exceed the duration of a run. Defaults to twice the Interval.
+	LeaseTTL time.Duration
+	// ErrorHandler is called with the errors of failed runs, and of the release
+	// of the lease when the worker stops. Failed runs are retried either way.
+	ErrorHandler func(error)
+	// Now returns the current time, which the lease is acquired at and expires
+	// against. It is called once at the start of each run. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// PurgeWorker runs PurgeExpired on an interval. The workers of the replicas
+// sharing a database coordinate through a lease row of the PurgeLease type,
+// so only one of them purges at a time.
+type PurgeWorker struct {
+	client *Client
+	cfg    PurgeWorkerConfig
+}
+
+// NewPurgeWorker returns a PurgeWorker purging with the given client.
+func NewPurgeWorker(c *Client, cfg PurgeWorkerConfig) *PurgeWorker {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultPurgeInterval
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = DefaultPurgeBackoff
+	}
+	if cfg.Backoff > cfg.Interval {
+		cfg.Backoff = cfg.Interval
+	}
+	if cfg.Lease == "" {
+		cfg.Lease = DefaultPurgeLease
+	}
+	if cfg.Holder == "" {
+		cfg.Holder = uuid.NewString()
+	}
+	if cfg.LeaseTTL <= 0 {
+		cfg.LeaseTTL = 2 * cfg.Interval
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	return &PurgeWorker{client: c, cfg: cfg}
+}
+
+// Run runs the worker until the context is canceled, and returns the error of the
+// context. Failed runs are retried with backoff. The lease is released on return.
+func (w *PurgeWorker) Run(ctx context.Context) error {
+	defer w.stop()
+	backoff := w.cfg.Backoff
+	for {
+		wait := w.cfg.Interval
+		if _, err := w.RunOnce(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if w.cfg.ErrorHandler != nil {
+				w.cfg.ErrorHandler(err)
+			}
+			wait, backoff = backoff, 2*backoff
+			if backoff > w.cfg.Interval {
+				backoff = w.cfg.Interval
+			}
+		} else {
+			backoff = w.cfg.Backoff
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// stop releases the lease of a stopping worker, whose context is already canceled.
+func (w *PurgeWorker) stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), purgeReleaseTimeout)
+	defer cancel()
+	if err := w.Release(ctx); err != nil && w.cfg.ErrorHandler != nil {
+		w.cfg.ErrorHandler(err)
+	}
+}
+
+// Release releases the lease if the worker holds it, so another worker can
+// take it over without waiting for it to expire.
+func (w *PurgeWorker) Release(ctx context.Context) error {
+	_, err := w.client.PurgeLease.Delete().
+		Where(purgelease.ID(w.cfg.Lease), purgelease.Holder(w.cfg.Holder)).
+		Exec(ctx)
+	return err
+}
+
+// RunOnce purges the expired rows if the worker holds the lease, and returns
+// how many rows were removed per type. A nil map is returned if the lease is
+// held by another worker.
+func (w *PurgeWorker) RunOnce(ctx context.Context) (map[string]int, error) {
+	acquired, err := w.acquire(ctx)
+	if err != nil || !acquired {
+		return nil, err
+	}
+	return w.client.PurgeExpired(ctx, w.cfg.PurgeOptions)
+}
+
+// acquire acquires or renews the lease of the worker, and reports if it holds it.
+func (w *PurgeWorker) acquire(ctx context.Context) (bool, error) {
+	now := w.cfg.Now()
+	n, err := w.client.PurgeLease.Update().
+		Where(
+			purgelease.ID(w.cfg.Lease),
+			purgelease.Or(purgelease.Holder(w.cfg.Holder), purgelease.ExpiresAtLT(now)),
+		).
+		SetHolder(w.cfg.Holder).
+		SetExpiresAt(now.Add(w.cfg.LeaseTTL)).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		return true, nil
+	}
+	err = w.client.PurgeLease.Create().
+		SetID(w.cfg.Lease).
+		SetHolder(w.cfg.Holder).
+		SetExpiresAt(now.Add(w.cfg.LeaseTTL)).
+		Exec(ctx)
+	switch {
+	case err == nil:
+		return true, nil
+	case IsConstraintError(err):
+		// The lease row exists and is held by another worker.
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+type restampDeletedTimeCtxKey struct{}
+
+// NewRestampDeletedTimeContext returns a new context that makes soft deletes
+// overwrite the deleted time of rows that were already soft-deleted.
+func NewRestampDeletedTimeContext(parent context.Context) context.Context {
+	return context.WithValue(parent, restampDeletedTimeCtxKey{}, true)
+}
+
+// restampDeletedTime reports if soft deletes should overwrite existing deleted times.
+func restampDeletedTime(ctx context.Context) bool {
+	restamp, _ := ctx.Value(restampDeletedTimeCtxKey{}).(bool)
+	return restamp
+}
+
+type updateDeletedCtxKey struct{}
+
+// NewUpdateDeletedContext returns a new context that allows the update builders
+// of soft-deletable types to modify soft-deleted rows, if the soft-delete policy
+// approves it. By default, updates skip them, and update-one builders fail with
+// a DeletedError.
+func NewUpdateDeletedContext(parent context.Context) context.Context {
+	return context.WithValue(parent, updateDeletedCtxKey{}, true)
+}
+
+type updateDeletedMutationCtxKey struct{}
+
+// newUpdateDeletedMutationContext returns a new context that allows the given update
+// mutation to modify soft-deleted rows, on behalf of the restores and soft deletes
+// that are authorized by themselves. Unlike NewUpdateDeletedContext, it does not
+// apply to the other mutations executed with the context.
+func newUpdateDeletedMutationContext(parent context.Context, m Mutation) context.Context {
+	return context.WithValue(parent, updateDeletedMutationCtxKey{}, m)
+}
+
+// updateDeleted reports if the given update mutation of the given type may modify
+// soft-deleted rows, and returns a BypassDeniedError if the context allows it but
+// the soft-delete policy denies it.
+func (c config) updateDeleted(ctx context.Context, typ string, m Mutation) (bool, error) {
+	if um, ok := ctx.Value(updateDeletedMutationCtxKey{}).(Mutation); ok && um == m {
+		return true, nil
+	}
+	if allow, _ := ctx.Value(updateDeletedCtxKey{}).(bool); !allow {
+		return false, nil
+	}
+	if err := c.authorizeBypass(ctx, typ, BypassUpdateDeleted); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SoftDeleteMutation is implemented by the mutations of the soft-deletable types.
+type SoftDeleteMutation interface {
+	Mutation
+	softDelete()
+}
+
+func (*EventMutation) softDelete()   {}
+func (*SessionMutation) softDelete() {}
+func (*TagMutation) softDelete()     {}
+func (*TodoMutation) softDelete()    {}
+func (*UserMutation) softDelete()    {}
+
+// DeletionInfo describes who soft-deleted rows and why. It is recorded
+// on the types that enable the audit fields of the soft-delete mixin.
+type DeletionInfo struct {
+	// By is the actor that deleted the rows.
+	By string
+	// Reason is the reason the rows were deleted for.
+	Reason string
+}
+
+type deletionInfoCtxKey struct{}
+
+// DeletionInfoFromContext returns the DeletionInfo stored inside a context, or a zero DeletionInfo if there isn't one.
+func DeletionInfoFromContext(ctx context.Context) DeletionInfo {
+	info, _ := ctx.Value(deletionInfoCtxKey{}).(DeletionInfo)
+	return info
+}
+
+// NewDeletionInfoContext returns a new context with the given DeletionInfo attached.
+func NewDeletionInfoContext(parent context.Context, info DeletionInfo) context.Context {
+	return context.WithValue(parent, deletionInfoCtxKey{}, info)
+}
+
+type softDeleteCtxKey struct{}
+
+// NewSoftDeleteContext returns a new context that makes the delete builder
+// executing the given mutation stamp the deleted time instead of removing rows,
+// even if the context requests hard deletes.
+func NewSoftDeleteContext(parent context.Context, m SoftDeleteMutation) context.Context {
+	return context.WithValue(parent, softDeleteCtxKey{}, m)
+}
+
+type hardDeleteCtxKey struct{}
+
+// NewHardDeleteContext returns a new context that makes the delete builders of
+// soft-deletable types remove the matched rows instead of soft-deleting them.
+func NewHardDeleteContext(parent context.Context) context.Context {
+	return context.WithValue(parent, hardDeleteCtxKey{}, true)
+}
+
+type hardDeleteMutationCtxKey struct{}
+
+// newHardDeleteMutationContext returns a new context that makes the given delete
+// mutation remove its rows. Unlike NewHardDeleteContext, it does not apply to the
+// other mutations executed with the context, such as the ones of hooks.
+func newHardDeleteMutationContext(parent context.Context, m Mutation) context.Context {
+	return context.WithValue(parent, hardDeleteMutationCtxKey{}, m)
+}
+
+type purgeMutationCtxKey struct{}
+
+// newPurgeMutationContext returns a new context that makes the given delete mutation
+// remove its rows on behalf of a purge. The purge is authorized by itself, and the
+// mutation is not subject to the policy of hard deletes.
+func newPurgeMutationContext(parent context.Context, m Mutation) context.Context {
+	ctx := newHardDeleteMutationContext(parent, m)
+	return context.WithValue(ctx, purgeMutationCtxKey{}, m)
+}
+
+type softDeleteUpdateCtxKey struct{}
+
+// newSoftDeleteUpdateContext returns a new context that marks the given update
+// mutation as stamping rows on behalf of a soft delete.
+func newSoftDeleteUpdateContext(parent context.Context, m Mutation) context.Context {
+	return context.WithValue(parent, softDeleteUpdateCtxKey{}, m)
+}
+
+// DeleteKind tells how a mutation relates to the deletion of rows.
+type DeleteKind uint
+
+const (
+	// DeleteKindNone is the kind of mutations that do not delete rows.
+	DeleteKindNone DeleteKind = iota
+	// DeleteKindSoft is the kind of delete mutations that soft-delete the matched rows.
+	DeleteKindSoft
+	// DeleteKindHard is the kind of delete mutations that remove the matched rows.
+	DeleteKindHard
+	// DeleteKindSoftUpdate is the kind of the update mutations that stamp rows
+	// on behalf of a soft delete. They run the update hooks of their type.
+	DeleteKindSoftUpdate
+)
+
+// DeleteKindOf returns the DeleteKind of the given mutation executed with the given
+// context. It is available to all hooks, and the delete builders of soft-deletable
+// types use it to decide whether they stamp or remove the matched rows.
+func DeleteKindOf(ctx context.Context, m Mutation) DeleteKind {
+	switch {
+	case m.Op().Is(OpDelete | OpDeleteOne):
+		if _, ok := m.(SoftDeleteMutation); !ok {
+			return DeleteKindHard
+		}
+		if sm, ok := ctx.Value(softDeleteCtxKey{}).(Mutation); ok && sm == m {
+			return DeleteKindSoft
+		}
+		if hard, _ := ctx.Value(hardDeleteCtxKey{}).(bool); hard {
+			return DeleteKindHard
+		}
+		if hm, ok := ctx.Value(hardDeleteMutationCtxKey{}).(Mutation); ok && hm == m {
+			return DeleteKindHard
+		}
+		return DeleteKindSoft
+	case m.Op().Is(OpUpdate | OpUpdateOne):
+		if u, ok := ctx.Value(softDeleteUpdateCtxKey{}).(Mutation); ok && u == m {
+			return DeleteKindSoftUpdate
+		}
+	}
+	return DeleteKindNone
+}
+
+// softDeleteCascade holds the state shared by a soft-delete operation and its cascades.
+type softDeleteCascade struct {
+	// time is the deleted time stamped on all rows of the cascade.
+	time time.Time
+	// batch is the deletion batch stamped on all rows of the cascade.
+	batch string
+	// visited holds the entities soft-deleted by the cascade so far.
+	visited map[softDeleteNode]struct{}
+}
+
+// softDeleteNode identifies an entity visited by a cascade.
+type softDeleteNode struct {
+	typ string
+	id  interface{}
+}
+
+type softDeleteCascadeCtxKey struct{}
+
+// softDeleteCascadeFromContext returns the cascade state stored inside a context.
+// If there isn't one, a new state is attached to the returned context.
+func softDeleteCascadeFromContext(ctx context.Context) (context.Context, *softDeleteCascade) {
+	if sdc, ok := ctx.Value(softDeleteCascadeCtxKey{}).(*softDeleteCascade); ok {
+		return ctx, sdc
+	}
+	return newSoftDeleteCascadeContext(ctx, time.Now())
+}
+
+// newSoftDeleteCascadeContext attaches a new cascade state, stamping the given
+// time, to the returned context.
+func newSoftDeleteCascadeContext(ctx context.Context, t time.Time) (context.Context, *softDeleteCascade) {
+	sdc := &softDeleteCascade{time: t, batch: uuid.NewString(), visited: make(map[softDeleteNode]struct{})}
+	return context.WithValue(ctx, softDeleteCascadeCtxKey{}, sdc), sdc
+}
+
+// visit marks the given entity as visited, and reports if it was not visited before.
+func (sdc *softDeleteCascade) visit(typ string, id interface{}) bool {
+	n := softDeleteNode{typ: typ, id: id}
+	if _, ok := sdc.visited[n]; ok {
+		return false
+	}
+	sdc.visited[n] = struct{}{}
+	return true
+}
+
+// restrictedSampleSize is the maximum number of blocking IDs reported by a RestrictedError.
+const restrictedSampleSize = 10
+
+// softDeleteChunkSize is the maximum number of IDs matched by a single statement
+// of the soft deletes and restores, keeping their statements below the limits of
+// the databases on bound parameters.
+const softDeleteChunkSize = 500
+
+// RestrictedError returns when a soft delete is blocked by the live neighbors
+// of an edge annotated with the restrict action.
+type RestrictedError struct {
+	// Type is the type of the entities that were deleted.
+	Type string
+	// Edge is the name of the blocking edge.
+	Edge string
+	// IDs holds a sample of the blocking neighbor IDs.
+	IDs []interface{}
+}
+
+// Error implements the error interface.
+func (e *RestrictedError) Error() string {
+	return fmt.Sprintf("ent: soft delete of %s restricted by edge %q (ids: %v)", e.Type, e.Edge, e.IDs)
+}
+
+// IsRestricted returns a boolean indicating whether the error is a restricted soft delete error.
+func IsRestricted(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *RestrictedError
+	return errors.As(err, &e)
+}
+
+// DeletedError returns when an operation targets an entity that exists but is
+// soft-deleted. It wraps a NotFoundError, so IsNotFound reports it as well, and
+// IsDeleted tells it apart from an entity that never existed.
+type DeletedError struct {
+	// Type is the type of the entity.
+	Type string
+	// ID is the id of the entity.
+	ID interface{}
+	// DeletedTime is the time the entity was soft-deleted at. It is
+	// zero for the types that store the deletion as a flag.
+	DeletedTime time.Time
+	label       string
+}
+
+// Error implements the error interface.
+func (e *DeletedError) Error() string {
+	return fmt.Sprintf("ent: %s %v is soft-deleted", e.Type, e.ID)
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *DeletedError) Unwrap() error {
+	return &NotFoundError{e.label}
+}
+
+// IsDeleted returns a boolean indicating whether the error is a soft-deleted entity error.
+func IsDeleted(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *DeletedError
+	return errors.As(err, &e)
+}
+
+// DeletedErrorOf returns a DeletedError if the entity of the given update-one or
+// delete-one mutation exists but is soft-deleted, and nil otherwise.
+func DeletedErrorOf(ctx context.Context, m Mutation) error {
+	var err error
+	switch m := m.(type) {
+	case *EventMutation:
+		if id, ok := m.ID(); ok {
+			err = m.Client().Event.DeletedError(ctx, id)
+		}
+	case *SessionMutation:
+		if id, ok := m.ID(); ok {
+			err = m.Client().Session.DeletedError(ctx, id)
+		}
+	case *TagMutation:
+		if id, ok := m.ID(); ok {
+			err = m.Client().Tag.DeletedError(ctx, id)
+		}
+	case *TodoMutation:
+		if id, ok := m.ID(); ok {
+			err = m.Client().Todo.DeletedError(ctx, id)
+		}
+	case *UserMutation:
+		if id, ok := m.ID(); ok {
+			err = m.Client().User.DeletedError(ctx, id)
+		}
+	}
+	if IsDeleted(err) {
+		return err
+	}
+	return nil
+}
+
+// UnsupportedTypeError returns when a soft-delete operation is given a type
+// that is unknown or does not use the soft-delete mixin.
+type UnsupportedTypeError struct {
+	// Type is the name of the type.
+	Type string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("ent: soft delete is not supported for type %s", e.Type)
+}
+
+// IsUnsupportedType returns a boolean indicating whether the error is an unsupported type error.
+func IsUnsupportedType(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *UnsupportedTypeError
+	return errors.As(err, &e)
+}
+
+// InvalidIDError returns when RestoreBatchForType is given an id that is
+// not of the ID type of the type it is given for.
+type InvalidIDError struct {
+	// Type is the name of the type.
+	Type string
+	// ID is the given id.
+	ID interface{}
+	// Expected is the Go type expected for the ID.
+	Expected string
+}
+
+// Error implements the error interface.
+func (e *InvalidIDError) Error() string {
+	return fmt.Sprintf("ent: unexpected id type %T for type %s. expect %s", e.ID, e.Type, e.Expected)
+}
+
+// IsInvalidID returns a boolean indicating whether the error is an invalid id error.
+func IsInvalidID(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *InvalidIDError
+	return errors.As(err, &e)
+}
+
+// NoDeletionBatchError returns when restoring the deletion batch of an entity
+// that was soft-deleted without one.
+type NoDeletionBatchError struct {
+	// Type is the name of the type.
+	Type string
+	// ID is the id of the entity.
+	ID interface{}
+}
+
+// Error implements the error interface.
+func (e *NoDeletionBatchError) Error() string {
+	return fmt.Sprintf("ent: %s %v has no deletion batch", e.Type, e.ID)
+}
+
+// IsNoDeletionBatch returns a boolean indicating whether the error is a no deletion batch error.
+func IsNoDeletionBatch(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *NoDeletionBatchError
+	return errors.As(err, &e)
+}
+
+// NoRetentionPolicyError returns when PurgeExpired is given a type that
+// declares no retention policy, and the purge is not forced.
+type NoRetentionPolicyError struct {
+	// Type is the name of the type.
+	Type string
+}
+
+// Error implements the error interface.
+func (e *NoRetentionPolicyError) Error() string {
+	return fmt.Sprintf("ent: type %s declares no retention policy", e.Type)
+}
+
+// IsNoRetentionPolicy returns a boolean indicating whether the error is a no retention policy error.
+func IsNoRetentionPolicy(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *NoRetentionPolicyError
+	return errors.As(err, &e)
+}
+
+// MissingCutoffError returns when a purge is executed without a cutoff.
+type MissingCutoffError struct {
+	// Type is the name of the type.
+	Type string
+}
+
+// Error implements the error interface.
+func (e *MissingCutoffError) Error() string {
+	return fmt.Sprintf("ent: missing cutoff for purging %s", e.Type)
+}
+
+// IsMissingCutoff returns a boolean indicating whether the error is a missing cutoff error.
+func IsMissingCutoff(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *MissingCutoffError
+	return errors.As(err, &e)
+}
+
+// NoDeletionTimeError returns when purging by a cutoff the rows of a type
+// that stores its soft deletes as a flag, without their deletion time.
+type NoDeletionTimeError struct {
+	// Type is the name of the type.
+	Type string
+}
+
+// Error implements the error interface.
+func (e *NoDeletionTimeError) Error() string {
+	return fmt.Sprintf("ent: %s does not store its deletion time and can not be purged by a cutoff", e.Type)
+}
+
+// IsNoDeletionTime returns a boolean indicating whether the error is a no deletion time error.
+func IsNoDeletionTime(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *NoDeletionTimeError
+	return errors.As(err, &e)
+}
+
+// BypassAction is an operation that bypasses the soft delete of a type.
+type BypassAction string
+
+const (
+	// BypassHardDelete removes rows instead of soft-deleting them.
+	BypassHardDelete BypassAction = "hard delete"
+	// BypassPurge permanently removes soft-deleted rows.
+	BypassPurge BypassAction = "purge"
+	// BypassViewDeleted queries soft-deleted rows, including the soft-deleted
+	// neighbors matched by the edge predicates of queries, updates and deletes.
+	BypassViewDeleted BypassAction = "view deleted"
+	// BypassRestore brings soft-deleted rows back to life.
+	BypassRestore BypassAction = "restore"
+	// BypassUpdateDeleted modifies soft-deleted rows with the update builders.
+	BypassUpdateDeleted BypassAction = "update deleted"
+)
+
+// BypassRule decides whether an operation may bypass the soft delete of a type.
+// Like the rules of the privacy package, it returns privacy.Allow or privacy.Deny
+// to make a decision, and privacy.Skip (or nil) to defer it to the next rule.
+type BypassRule interface {
+	EvalBypass(ctx context.Context, typ string, action BypassAction) error
+}
+
+// BypassRuleFunc type is an adapter to allow the use of ordinary
+// functions as bypass rules.
+type BypassRuleFunc func(context.Context, string, BypassAction) error
+
+// EvalBypass returns f(ctx, typ, action).
+func (f BypassRuleFunc) EvalBypass(ctx context.Context, typ string, action BypassAction) error {
+	return f(ctx, typ, action)
+}
+
+// BypassPolicy is a list of bypass rules evaluated in order. Following the privacy
+// policies, a decision stored in the context with privacy.DecisionContext takes
+// precedence over the rules, and the operations that all rules skip are allowed.
+// End the list with a rule returning privacy.Deny to deny them instead.
+type BypassPolicy []BypassRule
+
+// EvalBypass evaluates the rules of the policy. It returns nil if the operation is allowed.
+func (p BypassPolicy) EvalBypass(ctx context.Context, typ string, action BypassAction) error {
+	if decision, ok := privacy.DecisionFromContext(ctx); ok {
+		return decision
+	}
+	for _, rule := range p {
+		switch decision := rule.EvalBypass(ctx, typ, action); {
+		case decision == nil || errors.Is(decision, privacy.Skip):
+		case errors.Is(decision, privacy.Allow):
+			return nil
+		default:
+			return decision
+		}
+	}
+	return nil
+}
+
+// authorizeBypass evaluates the bypass policy of the config, and returns a
+// BypassDeniedError if it denies the action on the given type.
+func (c config) authorizeBypass(ctx context.Context, typ string, action BypassAction) error {
+	if c.bypass == nil {
+		return nil
+	}
+	if err := c.bypass.EvalBypass(ctx, typ, action); err != nil {
+		return &BypassDeniedError{Type: typ, Action: action, err: err}
+	}
+	return nil
+}
+
+// BypassDeniedError returns when the soft-delete policy of the client denies
+// an operation that bypasses the soft delete.
+type BypassDeniedError struct {
+	// Type is the type of the entities.
+	Type string
+	// Action is the denied operation.
+	Action BypassAction
+	err    error
+}
+
+// Error implements the error interface.
+func (e *BypassDeniedError) Error() string {
+	return fmt.Sprintf("ent: %s of %s denied: %v", e.Action, e.Type, e.err)
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *BypassDeniedError) Unwrap() error {
+	return e.err
+}
+
+// IsBypassDenied returns a boolean indicating whether the error is a denied bypass error.
+func IsBypassDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *BypassDeniedError
+	return errors.As(err, &e)
+}
+
+// softDeleteTx runs fn with a config bound to a transaction. The transaction of
+// the given config is used if it has one, otherwise a new one is started and
+// committed after fn succeeds.
+func softDeleteTx(ctx context.Context, cfg config, fn func(config) (int, error)) (int, error) {
+	if _, ok := cfg.driver.(*txDriver); ok {
+		return fn(cfg)
+	}
+	tx, err := newTx(ctx, cfg.driver)
+	if err != nil {
+		return 0, err
+	}
+	cfg.driver = tx
+	n, err := fn(cfg)
+	if err != nil {
+		if rerr := tx.tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return 0, err
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// DeletedTimeFilter controls how queries of soft-deletable types treat soft-deleted rows.
+type DeletedTimeFilter = predicate.DeletedTimeFilter
+
+const (
+	// DeletedTimeExclude filters soft-deleted rows out of the query results (default).
+	DeletedTimeExclude = predicate.DeletedTimeExclude
+	// DeletedTimeInclude returns both live and soft-deleted rows.
+	DeletedTimeInclude = predicate.DeletedTimeInclude
+	// DeletedTimeOnly returns only soft-deleted rows.
+	DeletedTimeOnly = predicate.DeletedTimeOnly
+)
+
+// DeletedTimeFilterFromContext returns the DeletedTimeFilter stored inside a context, or DeletedTimeExclude if there isn't one.
+func DeletedTimeFilterFromContext(ctx context.Context) DeletedTimeFilter {
+	return predicate.DeletedTimeFilterFromContext(ctx)
+}
+
+// NewDeletedTimeFilterContext returns a new context with the given DeletedTimeFilter attached.
+func NewDeletedTimeFilterContext(parent context.Context, f DeletedTimeFilter) context.Context {
+	return predicate.NewDeletedTimeFilterContext(parent, f)
+}
+
+// authorizeDeletedTimeFilter authorizes the soft-deleted rows of the given types,
+// and the soft-deleted neighbors matched by the edge predicates p of a statement
+// on the given table, unless the filter of the context is the default one.
+func (c config) authorizeDeletedTimeFilter(ctx context.Context, table string, p func(*sql.Selector), types ...string) error {
+	if DeletedTimeFilterFromContext(ctx) == DeletedTimeExclude {
+		return nil
+	}
+	if p != nil {
+		selector := sql.Dialect(c.driver.Dialect()).Select().From(sql.Table(table))
+		selector.WithContext(predicate.NewDeletedTimeTypesContext(ctx, &types))
+		p(selector)
+	}
+	for _, typ := range types {
+		if err := c.authorizeBypass(ctx, typ, BypassViewDeleted); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lifecycle holds the callbacks that the soft-deletable types run around their
+// soft deletes, restores and purges. It is installed on a client with the
+// LifecycleCallbacks option, and its callbacks should be registered before
+// the client is used.
+type Lifecycle struct {
+	Event   *EventLifecycle
+	Session *SessionLifecycle
+	Tag     *TagLifecycle
+	Todo    *TodoLifecycle
+	User    *UserLifecycle
+}
 
+// NewLifecycle returns a Lifecycle without callbacks.
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{
+		Event:   &EventLifecycle{},
+		Session: &SessionLifecycle{},
+		Tag:     &TagLifecycle{},
+		Todo:    &TodoLifecycle{},
+		User:    &UserLifecycle{},
 	}
+}
+
+// lifecycleEvent identifies the point of an operation that callbacks run at.
+type lifecycleEvent uint
+
+const (
+	beforeSoftDelete lifecycleEvent = iota
+	afterSoftDelete
+	beforeRestore
+	afterRestore
+	beforePurge
+	afterPurge
+	lifecycleEvents
+)
+
+// EventCallback is a lifecycle callback of Event. It receives the IDs of the
+// affected entities and a client bound to the transaction of the operation.
+// An error returned by a "before" callback vetoes the operation, and an error
+// returned by an "after" callback rolls it back.
+type EventCallback func(context.Context, *Client, []int) error
+
+// EventLifecycle holds the lifecycle callbacks of Event.
+type EventLifecycle struct {
+	callbacks [lifecycleEvents][]EventCallback
+}
+
+// BeforeSoftDelete registers callbacks that run before Event entities are soft-deleted.
+func (l *EventLifecycle) BeforeSoftDelete(fns ...EventCallback) {
+	l.callbacks[beforeSoftDelete] = append(l.callbacks[beforeSoftDelete], fns...)
+}
+
+// AfterSoftDelete registers callbacks that run after Event entities are soft-deleted.
+func (l *EventLifecycle) AfterSoftDelete(fns ...EventCallback) {
+	l.callbacks[afterSoftDelete] = append(l.callbacks[afterSoftDelete], fns...)
+}
+
+// BeforeRestore registers callbacks that run before Event entities are restored.
+func (l *EventLifecycle) BeforeRestore(fns ...EventCallback) {
+	l.callbacks[beforeRestore] = append(l.callbacks[beforeRestore], fns...)
+}
+
+// AfterRestore registers callbacks that run after Event entities are restored.
+func (l *EventLifecycle) AfterRestore(fns ...EventCallback) {
+	l.callbacks[afterRestore] = append(l.callbacks[afterRestore], fns...)
+}
+
+// BeforePurge registers callbacks that run before Event entities are purged.
+func (l *EventLifecycle) BeforePurge(fns ...EventCallback) {
+	l.callbacks[beforePurge] = append(l.callbacks[beforePurge], fns...)
+}
+
+// AfterPurge registers callbacks that run after Event entities are purged.
+func (l *EventLifecycle) AfterPurge(fns ...EventCallback) {
+	l.callbacks[afterPurge] = append(l.callbacks[afterPurge], fns...)
+}
+
+// has reports if callbacks are registered for any of the given events.
+func (l *EventLifecycle) has(events ...lifecycleEvent) bool {
+	if l == nil {
+		return false
+	}
+	for _, ev := range events {
+		if len(l.callbacks[ev]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// run calls the callbacks of the event in order, and stops at the first error.
+func (l *EventLifecycle) run(ctx context.Context, cfg config, ev lifecycleEvent, ids []int) error {
+	if !l.has(ev) {
+		return nil
+	}
+	client := &Client{config: cfg}
+	client.init()
+	for _, fn := range l.callbacks[ev] {
+		if err := fn(ctx, client, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventLifecycle returns the Event callbacks of the config, if any.
+func (c config) eventLifecycle() *EventLifecycle {
+	if c.lifecycle == nil {
+		return nil
+	}
+	return c.lifecycle.Event
+}
 
-	return fmt.Errorf("type (%s) not found", typ)
+// SessionCallback is a lifecycle callback of Session. It receives the IDs of the
+// affected entities and a client bound to the transaction of the operation.
+// An error returned by a "before" callback vetoes the operation, and an error
+// returned by an "after" callback rolls it back.
+type SessionCallback func(context.Context, *Client, []uuid.UUID) error
+
+// SessionLifecycle holds the lifecycle callbacks of Session.
+type SessionLifecycle struct {
+	callbacks [lifecycleEvents][]SessionCallback
+}
+
+// BeforeSoftDelete registers callbacks that run before Session entities are soft-deleted.
+func (l *SessionLifecycle) BeforeSoftDelete(fns ...SessionCallback) {
+	l.callbacks[beforeSoftDelete] = append(l.callbacks[beforeSoftDelete], fns...)
+}
+
+// AfterSoftDelete registers callbacks that run after Session entities are soft-deleted.
+func (l *SessionLifecycle) AfterSoftDelete(fns ...SessionCallback) {
+	l.callbacks[afterSoftDelete] = append(l.callbacks[afterSoftDelete], fns...)
+}
+
+// BeforeRestore registers callbacks that run before Session entities are restored.
+func (l *SessionLifecycle) BeforeRestore(fns ...SessionCallback) {
+	l.callbacks[beforeRestore] = append(l.callbacks[beforeRestore], fns...)
+}
+
+// AfterRestore registers callbacks that run after Session entities are restored.
+func (l *SessionLifecycle) AfterRestore(fns ...SessionCallback) {
+	l.callbacks[afterRestore] = append(l.callbacks[afterRestore], fns...)
+}
+
+// BeforePurge registers callbacks that run before Session entities are purged.
+func (l *SessionLifecycle) BeforePurge(fns ...SessionCallback) {
+	l.callbacks[beforePurge] = append(l.callbacks[beforePurge], fns...)
+}
+
+// AfterPurge registers callbacks that run after Session entities are purged.
+func (l *SessionLifecycle) AfterPurge(fns ...SessionCallback) {
+	l.callbacks[afterPurge] = append(l.callbacks[afterPurge], fns...)
+}
+
+// has reports if callbacks are registered for any of the given events.
+func (l *SessionLifecycle) has(events ...lifecycleEvent) bool {
+	if l == nil {
+		return false
+	}
+	for _, ev := range events {
+		if len(l.callbacks[ev]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// run calls the callbacks of the event in order, and stops at the first error.
+func (l *SessionLifecycle) run(ctx context.Context, cfg config, ev lifecycleEvent, ids []uuid.UUID) error {
+	if !l.has(ev) {
+		return nil
+	}
+	client := &Client{config: cfg}
+	client.init()
+	for _, fn := range l.callbacks[ev] {
+		if err := fn(ctx, client, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sessionLifecycle returns the Session callbacks of the config, if any.
+func (c config) sessionLifecycle() *SessionLifecycle {
+	if c.lifecycle == nil {
+		return nil
+	}
+	return c.lifecycle.Session
+}
+
+// TagCallback is a lifecycle callback of Tag. It receives the IDs of the
+// affected entities and a client bound to the transaction of the operation.
+// An error returned by a "before" callback vetoes the operation, and an error
+// returned by an "after" callback rolls it back.
+type TagCallback func(context.Context, *Client, []int) error
+
+// TagLifecycle holds the lifecycle callbacks of Tag.
+type TagLifecycle struct {
+	callbacks [lifecycleEvents][]TagCallback
+}
+
+// BeforeSoftDelete registers callbacks that run before Tag entities are soft-deleted.
+func (l *TagLifecycle) BeforeSoftDelete(fns ...TagCallback) {
+	l.callbacks[beforeSoftDelete] = append(l.callbacks[beforeSoftDelete], fns...)
+}
+
+// AfterSoftDelete registers callbacks that run after Tag entities are soft-deleted.
+func (l *TagLifecycle) AfterSoftDelete(fns ...TagCallback) {
+	l.callbacks[afterSoftDelete] = append(l.callbacks[afterSoftDelete], fns...)
+}
+
+// BeforeRestore registers callbacks that run before Tag entities are restored.
+func (l *TagLifecycle) BeforeRestore(fns ...TagCallback) {
+	l.callbacks[beforeRestore] = append(l.callbacks[beforeRestore], fns...)
+}
+
+// AfterRestore registers callbacks that run after Tag entities are restored.
+func (l *TagLifecycle) AfterRestore(fns ...TagCallback) {
+	l.callbacks[afterRestore] = append(l.callbacks[afterRestore], fns...)
+}
+
+// BeforePurge registers callbacks that run before Tag entities are purged.
+func (l *TagLifecycle) BeforePurge(fns ...TagCallback) {
+	l.callbacks[beforePurge] = append(l.callbacks[beforePurge], fns...)
+}
+
+// AfterPurge registers callbacks that run after Tag entities are purged.
+func (l *TagLifecycle) AfterPurge(fns ...TagCallback) {
+	l.callbacks[afterPurge] = append(l.callbacks[afterPurge], fns...)
+}
+
+// has reports if callbacks are registered for any of the given events.
+func (l *TagLifecycle) has(events ...lifecycleEvent) bool {
+	if l == nil {
+		return false
+	}
+	for _, ev := range events {
+		if len(l.callbacks[ev]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// run calls the callbacks of the event in order, and stops at the first error.
+func (l *TagLifecycle) run(ctx context.Context, cfg config, ev lifecycleEvent, ids []int) error {
+	if !l.has(ev) {
+		return nil
+	}
+	client := &Client{config: cfg}
+	client.init()
+	for _, fn := range l.callbacks[ev] {
+		if err := fn(ctx, client, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tagLifecycle returns the Tag callbacks of the config, if any.
+func (c config) tagLifecycle() *TagLifecycle {
+	if c.lifecycle == nil {
+		return nil
+	}
+	return c.lifecycle.Tag
+}
+
+// TodoCallback is a lifecycle callback of Todo. It receives the IDs of the
+// affected entities and a client bound to the transaction of the operation.
+// An error returned by a "before" callback vetoes the operation, and an error
+// returned by an "after" callback rolls it back.
+type TodoCallback func(context.Context, *Client, []int) error
+
+// TodoLifecycle holds the lifecycle callbacks of Todo.
+type TodoLifecycle struct {
+	callbacks [lifecycleEvents][]TodoCallback
+}
+
+// BeforeSoftDelete registers callbacks that run before Todo entities are soft-deleted.
+func (l *TodoLifecycle) BeforeSoftDelete(fns ...TodoCallback) {
+	l.callbacks[beforeSoftDelete] = append(l.callbacks[beforeSoftDelete], fns...)
+}
+
+// AfterSoftDelete registers callbacks that run after Todo entities are soft-deleted.
+func (l *TodoLifecycle) AfterSoftDelete(fns ...TodoCallback) {
+	l.callbacks[afterSoftDelete] = append(l.callbacks[afterSoftDelete], fns...)
+}
+
+// BeforeRestore registers callbacks that run before Todo entities are restored.
+func (l *TodoLifecycle) BeforeRestore(fns ...TodoCallback) {
+	l.callbacks[beforeRestore] = append(l.callbacks[beforeRestore], fns...)
+}
+
+// AfterRestore registers callbacks that run after Todo entities are restored.
+func (l *TodoLifecycle) AfterRestore(fns ...TodoCallback) {
+	l.callbacks[afterRestore] = append(l.callbacks[afterRestore], fns...)
+}
+
+// BeforePurge registers callbacks that run before Todo entities are purged.
+func (l *TodoLifecycle) BeforePurge(fns ...TodoCallback) {
+	l.callbacks[beforePurge] = append(l.callbacks[beforePurge], fns...)
+}
+
+// AfterPurge registers callbacks that run after Todo entities are purged.
+func (l *TodoLifecycle) AfterPurge(fns ...TodoCallback) {
+	l.callbacks[afterPurge] = append(l.callbacks[afterPurge], fns...)
+}
+
+// has reports if callbacks are registered for any of the given events.
+func (l *TodoLifecycle) has(events ...lifecycleEvent) bool {
+	if l == nil {
+		return false
+	}
+	for _, ev := range events {
+		if len(l.callbacks[ev]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// run calls the callbacks of the event in order, and stops at the first error.
+func (l *TodoLifecycle) run(ctx context.Context, cfg config, ev lifecycleEvent, ids []int) error {
+	if !l.has(ev) {
+		return nil
+	}
+	client := &Client{config: cfg}
+	client.init()
+	for _, fn := range l.callbacks[ev] {
+		if err := fn(ctx, client, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// todoLifecycle returns the Todo callbacks of the config, if any.
+func (c config) todoLifecycle() *TodoLifecycle {
+	if c.lifecycle == nil {
+		return nil
+	}
+	return c.lifecycle.Todo
+}
+
+// UserCallback is a lifecycle callback of User. It receives the IDs of the
+// affected entities and a client bound to the transaction of the operation.
+// An error returned by a "before" callback vetoes the operation, and an error
+// returned by an "after" callback rolls it back.
+type UserCallback func(context.Context, *Client, []int) error
+
+// UserLifecycle holds the lifecycle callbacks of User.
+type UserLifecycle struct {
+	callbacks [lifecycleEvents][]UserCallback
+}
+
+// BeforeSoftDelete registers callbacks that run before User entities are soft-deleted.
+func (l *UserLifecycle) BeforeSoftDelete(fns ...UserCallback) {
+	l.callbacks[beforeSoftDelete] = append(l.callbacks[beforeSoftDelete], fns...)
+}
+
+// AfterSoftDelete registers callbacks that run after User entities are soft-deleted.
+func (l *UserLifecycle) AfterSoftDelete(fns ...UserCallback) {
+	l.callbacks[afterSoftDelete] = append(l.callbacks[afterSoftDelete], fns...)
+}
+
+// BeforeRestore registers callbacks that run before User entities are restored.
+func (l *UserLifecycle) BeforeRestore(fns ...UserCallback) {
+	l.callbacks[beforeRestore] = append(l.callbacks[beforeRestore], fns...)
+}
+
+// AfterRestore registers callbacks that run after User entities are restored.
+func (l *UserLifecycle) AfterRestore(fns ...UserCallback) {
+	l.callbacks[afterRestore] = append(l.callbacks[afterRestore], fns...)
+}
+
+// BeforePurge registers callbacks that run before User entities are purged.
+func (l *UserLifecycle) BeforePurge(fns ...UserCallback) {
+	l.callbacks[beforePurge] = append(l.callbacks[beforePurge], fns...)
+}
+
+// AfterPurge registers callbacks that run after User entities are purged.
+func (l *UserLifecycle) AfterPurge(fns ...UserCallback) {
+	l.callbacks[afterPurge] = append(l.callbacks[afterPurge], fns...)
+}
+
+// has reports if callbacks are registered for any of the given events.
+func (l *UserLifecycle) has(events ...lifecycleEvent) bool {
+	if l == nil {
+		return false
+	}
+	for _, ev := range events {
+		if len(l.callbacks[ev]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// run calls the callbacks of the event in order, and stops at the first error.
+func (l *UserLifecycle) run(ctx context.Context, cfg config, ev lifecycleEvent, ids []int) error {
+	if !l.has(ev) {
+		return nil
+	}
+	client := &Client{config: cfg}
+	client.init()
+	for _, fn := range l.callbacks[ev] {
+		if err := fn(ctx, client, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// userLifecycle returns the User callbacks of the config, if any.
+func (c config) userLifecycle() *UserLifecycle {
+	if c.lifecycle == nil {
+		return nil
+	}
+	return c.lifecycle.User
+}
+
+// eventNotDeleted returns the predicate matching the Event rows that are not soft-deleted.
+func eventNotDeleted() predicate.Event {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(s.C("deleted_at") + " " + predicate.EventLiveCondition))
+	}
+}
+
+// eventDeleted returns the predicate matching the soft-deleted Event rows.
+func eventDeleted() predicate.Event {
+	return func(s *sql.Selector) {
+		s.Where(sql.Not(sql.ExprP(s.C("deleted_at") + " " + predicate.EventLiveCondition)))
+	}
+}
+
+// eventDeletedBefore returns the predicate matching the Event rows soft-deleted before t.
+func eventDeletedBefore(t time.Time) predicate.Event {
+	return event.And(eventDeleted(), event.DeletedAtLT(t.Unix()))
+}
+
+// eventIDChunks splits the given ids into chunks of at most softDeleteChunkSize ids.
+func eventIDChunks(ids []int) [][]int {
+	chunks := make([][]int, 0, (len(ids)+softDeleteChunkSize-1)/softDeleteChunkSize)
+	for len(ids) > softDeleteChunkSize {
+		chunks = append(chunks, ids[:softDeleteChunkSize])
+		ids = ids[softDeleteChunkSize:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// deletedTime returns the time recorded in the soft-delete field of the Event.
+func (e *Event) deletedTime() time.Time {
+	return time.Unix(e.DeletedAt, 0)
+}
+
+// markDeleted sets the soft-delete fields of the mutation to mark the rows deleted at t by the given batch.
+func (m *EventMutation) markDeleted(ctx context.Context, t time.Time, batch string) {
+	m.SetDeletedAt(t.Unix())
+	m.SetDeletedBatch(batch)
+}
+
+// markLive resets the soft-delete fields of the mutation to the values of live rows.
+func (m *EventMutation) markLive() {
+	m.SetDeletedAt(0)
+	m.ClearDeletedBatch()
+}
+
+// SetDeletedTime soft-deletes the Event entities with the given ids at time t,
+// and returns how many of them were deleted. It runs the soft delete of the delete
+// builders, including their hooks, edge actions and lifecycle callbacks, and the
+// cascaded entities are stamped with t as well.
+func (c *EventClient) SetDeletedTime(ctx context.Context, t time.Time, ids ...int) (int, error) {
+	ctx, _ = newSoftDeleteCascadeContext(ctx, t)
+	d := c.Delete().Where(event.IDIn(ids...))
+	return d.Exec(NewSoftDeleteContext(ctx, d.mutation))
+}
+
+// DeletedError returns a DeletedError if the Event with the given id exists
+// but is soft-deleted, and nil if it is live or missing. It tells the two apart
+// after a NotFoundError.
+func (c *EventClient) DeletedError(ctx context.Context, id int) error {
+	e, err := c.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(event.ID(id)).Only(ctx)
+	switch {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return &DeletedError{Type: TypeEvent, ID: id, DeletedTime: e.deletedTime(), label: event.Label}
+}
+
+// Restore returns a restore builder for Event.
+func (c *EventClient) Restore() *EventRestore {
+	return &EventRestore{config: c.config}
+}
+
+// RestoreOne returns a restore builder for the given entity.
+func (c *EventClient) RestoreOne(e *Event) *EventRestoreOne {
+	return c.RestoreOneID(e.ID)
+}
+
+// RestoreOneID returns a restore builder for the given id.
+func (c *EventClient) RestoreOneID(id int) *EventRestoreOne {
+	builder := c.Restore().Where(event.ID(id))
+	return &EventRestoreOne{builder}
+}
+
+// EventRestore is the builder for restoring soft-deleted Event entities.
+type EventRestore struct {
+	config
+	predicates []predicate.Event
+}
+
+// Where appends a list predicates to the EventRestore builder.
+func (er *EventRestore) Where(ps ...predicate.Event) *EventRestore {
+	er.predicates = append(er.predicates, ps...)
+	return er
+}
+
+// Exec executes the restore query and returns how many vertices were restored.
+// Only rows that are currently soft-deleted are affected. If lifecycle callbacks
+// are registered for restores, they run with the restore in a transaction. The
+// restore fails with a BypassDeniedError if the soft-delete policy denies it.
+func (er *EventRestore) Exec(ctx context.Context) (int, error) {
+	if err := er.authorizeBypass(ctx, TypeEvent, BypassRestore); err != nil {
+		return 0, err
+	}
+	if !er.eventLifecycle().has(beforeRestore, afterRestore) {
+		update := NewEventClient(er.config).Update().
+			Where(er.predicates...).
+			Where(eventDeleted())
+		update.mutation.markLive()
+		return update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+	}
+	return softDeleteTx(ctx, er.config, func(cfg config) (int, error) {
+		// The filter applies to the restored rows only, so the edge predicates match
+		// the same neighbors as without callbacks.
+		ids, err := NewEventClient(cfg).Query().
+			withDeletedTimeFilter(DeletedTimeOnly).
+			Where(er.predicates...).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		if err := cfg.eventLifecycle().run(ctx, cfg, beforeRestore, ids); err != nil {
+			return 0, err
+		}
+		var n int
+		for _, chunk := range eventIDChunks(ids) {
+			update := NewEventClient(cfg).Update().
+				Where(event.IDIn(chunk...), eventDeleted())
+			update.mutation.markLive()
+			affected, err := update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		if err := cfg.eventLifecycle().run(ctx, cfg, afterRestore, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (er *EventRestore) ExecX(ctx context.Context) int {
+	n, err := er.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// EventRestoreOne is the builder for restoring a single soft-deleted Event entity.
+type EventRestoreOne struct {
+	er *EventRestore
+}
+
+// Exec executes the restore query.
+func (ero *EventRestoreOne) Exec(ctx context.Context) error {
+	n, err := ero.er.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{event.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ero *EventRestoreOne) ExecX(ctx context.Context) {
+	if err := ero.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// HardDelete returns a builder that removes Event entities for good,
+// instead of soft-deleting them. The bypass applies to this operation only,
+// and not to the operations that hooks execute with the same context.
+func (c *EventClient) HardDelete() *EventHardDelete {
+	return &EventHardDelete{c.Delete()}
+}
+
+// HardDeleteOne returns a builder that removes the given entity for good.
+func (c *EventClient) HardDeleteOne(e *Event) *EventHardDeleteOne {
+	return c.HardDeleteOneID(e.ID)
+}
+
+// HardDeleteOneID returns a builder that removes the entity with the given id for good.
+func (c *EventClient) HardDeleteOneID(id int) *EventHardDeleteOne {
+	builder := c.HardDelete().Where(event.ID(id))
+	builder.delete.mutation.id = &id
+	builder.delete.mutation.op = OpDeleteOne
+	return &EventHardDeleteOne{builder}
+}
+
+// EventHardDelete is the builder for removing Event entities for good.
+type EventHardDelete struct {
+	delete *EventDelete
+}
+
+// Where appends a list predicates to the EventHardDelete builder.
+func (ehd *EventHardDelete) Where(ps ...predicate.Event) *EventHardDelete {
+	ehd.delete.Where(ps...)
+	return ehd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ehd *EventHardDelete) Exec(ctx context.Context) (int, error) {
+	return ehd.delete.Exec(newHardDeleteMutationContext(ctx, ehd.delete.mutation))
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehd *EventHardDelete) ExecX(ctx context.Context) int {
+	n, err := ehd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// EventHardDeleteOne is the builder for removing a single Event entity for good.
+type EventHardDeleteOne struct {
+	ehd *EventHardDelete
+}
+
+// Exec executes the deletion query.
+func (ehdo *EventHardDeleteOne) Exec(ctx context.Context) error {
+	n, err := ehdo.ehd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{event.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ehdo *EventHardDeleteOne) ExecX(ctx context.Context) {
+	if err := ehdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Purge returns a builder for permanently removing soft-deleted Event entities.
+func (c *EventClient) Purge() *EventPurge {
+	return &EventPurge{config: c.config}
+}
+
+// EventPurge is the builder for permanently removing soft-deleted Event entities.
+type EventPurge struct {
+	config
+	predicates    []predicate.Event
+	before        time.Time
+	batchSize     int
+	rowsPerSecond int
+}
+
+// Where appends a list predicates to the EventPurge builder.
+func (ep *EventPurge) Where(ps ...predicate.Event) *EventPurge {
+	ep.predicates = append(ep.predicates, ps...)
+	return ep
+}
+
+// Before sets the cutoff of the purge. Only rows soft-deleted before t are removed.
+func (ep *EventPurge) Before(t time.Time) *EventPurge {
+	ep.before = t
+	return ep
+}
+
+// BatchSize sets the maximum number of rows removed by a single statement.
+func (ep *EventPurge) BatchSize(n int) *EventPurge {
+	ep.batchSize = n
+	return ep
+}
+
+// RowsPerSecond limits the rate at which rows are removed. Zero means no limit.
+func (ep *EventPurge) RowsPerSecond(n int) *EventPurge {
+	ep.rowsPerSecond = n
+	return ep
+}
+
+// Exec executes the purge and returns how many rows were removed. Each batch
+// is removed in a transaction, along with its lifecycle callbacks. The purge
+// fails with a BypassDeniedError if the soft-delete policy denies it.
+func (ep *EventPurge) Exec(ctx context.Context) (int, error) {
+	if ep.before.IsZero() {
+		return 0, &MissingCutoffError{Type: TypeEvent}
+	}
+	if err := ep.authorizeBypass(ctx, TypeEvent, BypassPurge); err != nil {
+		return 0, err
+	}
+	size := ep.batchSize
+	if size <= 0 {
+		size = DefaultPurgeBatchSize
+	}
+	var total int
+	for {
+		ids, err := NewEventClient(ep.config).Query().
+			withDeletedTimeFilter(DeletedTimeInclude).
+			Where(ep.predicates...).
+			Where(eventDeletedBefore(ep.before)).
+			Limit(size).
+			IDs(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		n, err := softDeleteTx(ctx, ep.config, func(cfg config) (int, error) {
+			if err := cfg.eventLifecycle().run(ctx, cfg, beforePurge, ids); err != nil {
+				return 0, err
+			}
+			// The builder is executed without its hooks, as the rows are removed for good.
+			purge := NewEventClient(cfg).Delete().
+				Where(event.IDIn(ids...), eventDeletedBefore(ep.before))
+			n, err := purge.sqlExec(newPurgeMutationContext(ctx, purge.mutation))
+			if err != nil {
+				return 0, err
+			}
+			if err := cfg.eventLifecycle().run(ctx, cfg, afterPurge, ids); err != nil {
+				return 0, err
+			}
+			return n, nil
+		})
+		total += n
+		if err != nil || len(ids) < size {
+			return total, err
+		}
+		if ep.rowsPerSecond > 0 {
+			select {
+			case <-ctx.Done():
+				return total, ctx.Err()
+			case <-time.After(time.Duration(n) * time.Second / time.Duration(ep.rowsPerSecond)):
+			}
+		}
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ep *EventPurge) ExecX(ctx context.Context) int {
+	n, err := ep.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// sessionNotDeleted returns the predicate matching the Session rows that are not soft-deleted.
+func sessionNotDeleted() predicate.Session {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(s.C("deleted_at") + " " + predicate.SessionLiveCondition))
+	}
+}
+
+// sessionDeleted returns the predicate matching the soft-deleted Session rows.
+func sessionDeleted() predicate.Session {
+	return func(s *sql.Selector) {
+		s.Where(sql.Not(sql.ExprP(s.C("deleted_at") + " " + predicate.SessionLiveCondition)))
+	}
+}
+
+// sessionDeletedBefore returns the predicate matching the Session rows soft-deleted before t.
+func sessionDeletedBefore(t time.Time) predicate.Session {
+	return session.And(sessionDeleted(), session.DeletedAtLT(t))
+}
+
+// sessionIDChunks splits the given ids into chunks of at most softDeleteChunkSize ids.
+func sessionIDChunks(ids []uuid.UUID) [][]uuid.UUID {
+	chunks := make([][]uuid.UUID, 0, (len(ids)+softDeleteChunkSize-1)/softDeleteChunkSize)
+	for len(ids) > softDeleteChunkSize {
+		chunks = append(chunks, ids[:softDeleteChunkSize])
+		ids = ids[softDeleteChunkSize:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// deletedTime returns the time recorded in the soft-delete field of the Session.
+func (s *Session) deletedTime() time.Time {
+	return s.DeletedAt
+}
+
+// markDeleted sets the soft-delete fields of the mutation to mark the rows deleted at t by the given batch.
+func (m *SessionMutation) markDeleted(ctx context.Context, t time.Time, batch string) {
+	m.SetDeletedAt(t)
+	m.SetDeletedBatch(batch)
+}
+
+// markLive resets the soft-delete fields of the mutation to the values of live rows.
+func (m *SessionMutation) markLive() {
+	m.SetDeletedAt(session.DefaultDeletedAt())
+	m.ClearDeletedBatch()
+}
+
+// SetDeletedTime soft-deletes the Session entities with the given ids at time t,
+// and returns how many of them were deleted. It runs the soft delete of the delete
+// builders, including their hooks, edge actions and lifecycle callbacks, and the
+// cascaded entities are stamped with t as well.
+func (c *SessionClient) SetDeletedTime(ctx context.Context, t time.Time, ids ...uuid.UUID) (int, error) {
+	ctx, _ = newSoftDeleteCascadeContext(ctx, t)
+	d := c.Delete().Where(session.IDIn(ids...))
+	return d.Exec(NewSoftDeleteContext(ctx, d.mutation))
+}
+
+// DeletedError returns a DeletedError if the Session with the given id exists
+// but is soft-deleted, and nil if it is live or missing. It tells the two apart
+// after a NotFoundError.
+func (c *SessionClient) DeletedError(ctx context.Context, id uuid.UUID) error {
+	s, err := c.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(session.ID(id)).Only(ctx)
+	switch {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return &DeletedError{Type: TypeSession, ID: id, DeletedTime: s.deletedTime(), label: session.Label}
+}
+
+// Restore returns a restore builder for Session.
+func (c *SessionClient) Restore() *SessionRestore {
+	return &SessionRestore{config: c.config}
+}
+
+// RestoreOne returns a restore builder for the given entity.
+func (c *SessionClient) RestoreOne(s *Session) *SessionRestoreOne {
+	return c.RestoreOneID(s.ID)
+}
+
+// RestoreOneID returns a restore builder for the given id.
+func (c *SessionClient) RestoreOneID(id uuid.UUID) *SessionRestoreOne {
+	builder := c.Restore().Where(session.ID(id))
+	return &SessionRestoreOne{builder}
+}
+
+// SessionRestore is the builder for restoring soft-deleted Session entities.
+type SessionRestore struct {
+	config
+	predicates []predicate.Session
+}
+
+// Where appends a list predicates to the SessionRestore builder.
+func (sr *SessionRestore) Where(ps ...predicate.Session) *SessionRestore {
+	sr.predicates = append(sr.predicates, ps...)
+	return sr
+}
+
+// Exec executes the restore query and returns how many vertices were restored.
+// Only rows that are currently soft-deleted are affected. If lifecycle callbacks
+// are registered for restores, they run with the restore in a transaction. The
+// restore fails with a BypassDeniedError if the soft-delete policy denies it.
+func (sr *SessionRestore) Exec(ctx context.Context) (int, error) {
+	if err := sr.authorizeBypass(ctx, TypeSession, BypassRestore); err != nil {
+		return 0, err
+	}
+	if !sr.sessionLifecycle().has(beforeRestore, afterRestore) {
+		update := NewSessionClient(sr.config).Update().
+			Where(sr.predicates...).
+			Where(sessionDeleted())
+		update.mutation.markLive()
+		return update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+	}
+	return softDeleteTx(ctx, sr.config, func(cfg config) (int, error) {
+		// The filter applies to the restored rows only, so the edge predicates match
+		// the same neighbors as without callbacks.
+		ids, err := NewSessionClient(cfg).Query().
+			withDeletedTimeFilter(DeletedTimeOnly).
+			Where(sr.predicates...).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		if err := cfg.sessionLifecycle().run(ctx, cfg, beforeRestore, ids); err != nil {
+			return 0, err
+		}
+		var n int
+		for _, chunk := range sessionIDChunks(ids) {
+			update := NewSessionClient(cfg).Update().
+				Where(session.IDIn(chunk...), sessionDeleted())
+			update.mutation.markLive()
+			affected, err := update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		if err := cfg.sessionLifecycle().run(ctx, cfg, afterRestore, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sr *SessionRestore) ExecX(ctx context.Context) int {
+	n, err := sr.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// SessionRestoreOne is the builder for restoring a single soft-deleted Session entity.
+type SessionRestoreOne struct {
+	sr *SessionRestore
+}
+
+// Exec executes the restore query.
+func (sro *SessionRestoreOne) Exec(ctx context.Context) error {
+	n, err := sro.sr.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{session.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sro *SessionRestoreOne) ExecX(ctx context.Context) {
+	if err := sro.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// HardDelete returns a builder that removes Session entities for good,
+// instead of soft-deleting them. The bypass applies to this operation only,
+// and not to the operations that hooks execute with the same context.
+func (c *SessionClient) HardDelete() *SessionHardDelete {
+	return &SessionHardDelete{c.Delete()}
+}
+
+// HardDeleteOne returns a builder that removes the given entity for good.
+func (c *SessionClient) HardDeleteOne(s *Session) *SessionHardDeleteOne {
+	return c.HardDeleteOneID(s.ID)
+}
+
+// HardDeleteOneID returns a builder that removes the entity with the given id for good.
+func (c *SessionClient) HardDeleteOneID(id uuid.UUID) *SessionHardDeleteOne {
+	builder := c.HardDelete().Where(session.ID(id))
+	builder.delete.mutation.id = &id
+	builder.delete.mutation.op = OpDeleteOne
+	return &SessionHardDeleteOne{builder}
+}
+
+// SessionHardDelete is the builder for removing Session entities for good.
+type SessionHardDelete struct {
+	delete *SessionDelete
+}
+
+// Where appends a list predicates to the SessionHardDelete builder.
+func (shd *SessionHardDelete) Where(ps ...predicate.Session) *SessionHardDelete {
+	shd.delete.Where(ps...)
+	return shd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (shd *SessionHardDelete) Exec(ctx context.Context) (int, error) {
+	return shd.delete.Exec(newHardDeleteMutationContext(ctx, shd.delete.mutation))
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shd *SessionHardDelete) ExecX(ctx context.Context) int {
+	n, err := shd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// SessionHardDeleteOne is the builder for removing a single Session entity for good.
+type SessionHardDeleteOne struct {
+	shd *SessionHardDelete
+}
+
+// Exec executes the deletion query.
+func (shdo *SessionHardDeleteOne) Exec(ctx context.Context) error {
+	n, err := shdo.shd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{session.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shdo *SessionHardDeleteOne) ExecX(ctx context.Context) {
+	if err := shdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Purge returns a builder for permanently removing soft-deleted Session entities.
+func (c *SessionClient) Purge() *SessionPurge {
+	return &SessionPurge{config: c.config}
+}
+
+// SessionPurge is the builder for permanently removing soft-deleted Session entities.
+type SessionPurge struct {
+	config
+	predicates    []predicate.Session
+	before        time.Time
+	batchSize     int
+	rowsPerSecond int
+}
+
+// Where appends a list predicates to the SessionPurge builder.
+func (sp *SessionPurge) Where(ps ...predicate.Session) *SessionPurge {
+	sp.predicates = append(sp.predicates, ps...)
+	return sp
+}
+
+// Before sets the cutoff of the purge. Only rows soft-deleted before t are removed.
+func (sp *SessionPurge) Before(t time.Time) *SessionPurge {
+	sp.before = t
+	return sp
+}
+
+// BatchSize sets the maximum number of rows removed by a single statement.
+func (sp *SessionPurge) BatchSize(n int) *SessionPurge {
+	sp.batchSize = n
+	return sp
+}
+
+// RowsPerSecond limits the rate at which rows are removed. Zero means no limit.
+func (sp *SessionPurge) RowsPerSecond(n int) *SessionPurge {
+	sp.rowsPerSecond = n
+	return sp
+}
+
+// Exec executes the purge and returns how many rows were removed. Each batch
+// is removed in a transaction, along with its lifecycle callbacks. The purge
+// fails with a BypassDeniedError if the soft-delete policy denies it.
+func (sp *SessionPurge) Exec(ctx context.Context) (int, error) {
+	if sp.before.IsZero() {
+		return 0, &MissingCutoffError{Type: TypeSession}
+	}
+	if err := sp.authorizeBypass(ctx, TypeSession, BypassPurge); err != nil {
+		return 0, err
+	}
+	size := sp.batchSize
+	if size <= 0 {
+		size = DefaultPurgeBatchSize
+	}
+	var total int
+	for {
+		ids, err := NewSessionClient(sp.config).Query().
+			withDeletedTimeFilter(DeletedTimeInclude).
+			Where(sp.predicates...).
+			Where(sessionDeletedBefore(sp.before)).
+			Limit(size).
+			IDs(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		n, err := softDeleteTx(ctx, sp.config, func(cfg config) (int, error) {
+			if err := cfg.sessionLifecycle().run(ctx, cfg, beforePurge, ids); err != nil {
+				return 0, err
+			}
+			// The builder is executed without its hooks, as the rows are removed for good.
+			purge := NewSessionClient(cfg).Delete().
+				Where(session.IDIn(ids...), sessionDeletedBefore(sp.before))
+			n, err := purge.sqlExec(newPurgeMutationContext(ctx, purge.mutation))
+			if err != nil {
+				return 0, err
+			}
+			if err := cfg.sessionLifecycle().run(ctx, cfg, afterPurge, ids); err != nil {
+				return 0, err
+			}
+			return n, nil
+		})
+		total += n
+		if err != nil || len(ids) < size {
+			return total, err
+		}
+		if sp.rowsPerSecond > 0 {
+			select {
+			case <-ctx.Done():
+				return total, ctx.Err()
+			case <-time.After(time.Duration(n) * time.Second / time.Duration(sp.rowsPerSecond)):
+			}
+		}
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sp *SessionPurge) ExecX(ctx context.Context) int {
+	n, err := sp.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// tagNotDeleted returns the predicate matching the Tag rows that are not soft-deleted.
+func tagNotDeleted() predicate.Tag {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(s.C("is_deleted") + " " + predicate.TagLiveCondition))
+	}
+}
+
+// tagDeleted returns the predicate matching the soft-deleted Tag rows.
+func tagDeleted() predicate.Tag {
+	return func(s *sql.Selector) {
+		s.Where(sql.Not(sql.ExprP(s.C("is_deleted") + " " + predicate.TagLiveCondition)))
+	}
+}
+
+// tagIDChunks splits the given ids into chunks of at most softDeleteChunkSize ids.
+func tagIDChunks(ids []int) [][]int {
+	chunks := make([][]int, 0, (len(ids)+softDeleteChunkSize-1)/softDeleteChunkSize)
+	for len(ids) > softDeleteChunkSize {
+		chunks = append(chunks, ids[:softDeleteChunkSize])
+		ids = ids[softDeleteChunkSize:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// deletedTime returns the time recorded in the soft-delete field of the Tag.
+func (t *Tag) deletedTime() time.Time {
+	return time.Time{}
+}
+
+// markDeleted sets the soft-delete fields of the mutation to mark the rows deleted at t by the given batch.
+func (m *TagMutation) markDeleted(ctx context.Context, t time.Time, batch string) {
+	m.SetIsDeleted(true)
+	m.SetDeletedBatch(batch)
+}
+
+// markLive resets the soft-delete fields of the mutation to the values of live rows.
+func (m *TagMutation) markLive() {
+	m.SetIsDeleted(false)
+	m.ClearDeletedBatch()
+}
+
+// SetDeletedTime soft-deletes the Tag entities with the given ids at time t,
+// and returns how many of them were deleted. It runs the soft delete of the delete
+// builders, including their hooks, edge actions and lifecycle callbacks, and the
+// cascaded entities are stamped with t as well.
+func (c *TagClient) SetDeletedTime(ctx context.Context, t time.Time, ids ...int) (int, error) {
+	ctx, _ = newSoftDeleteCascadeContext(ctx, t)
+	d := c.Delete().Where(tag.IDIn(ids...))
+	return d.Exec(NewSoftDeleteContext(ctx, d.mutation))
+}
+
+// DeletedError returns a DeletedError if the Tag with the given id exists
+// but is soft-deleted, and nil if it is live or missing. It tells the two apart
+// after a NotFoundError.
+func (c *TagClient) DeletedError(ctx context.Context, id int) error {
+	t, err := c.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(tag.ID(id)).Only(ctx)
+	switch {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return &DeletedError{Type: TypeTag, ID: id, DeletedTime: t.deletedTime(), label: tag.Label}
+}
+
+// Restore returns a restore builder for Tag.
+func (c *TagClient) Restore() *TagRestore {
+	return &TagRestore{config: c.config}
+}
+
+// RestoreOne returns a restore builder for the given entity.
+func (c *TagClient) RestoreOne(t *Tag) *TagRestoreOne {
+	return c.RestoreOneID(t.ID)
+}
+
+// RestoreOneID returns a restore builder for the given id.
+func (c *TagClient) RestoreOneID(id int) *TagRestoreOne {
+	builder := c.Restore().Where(tag.ID(id))
+	return &TagRestoreOne{builder}
+}
+
+// TagRestore is the builder for restoring soft-deleted Tag entities.
+type TagRestore struct {
+	config
+	predicates []predicate.Tag
+}
+
+// Where appends a list predicates to the TagRestore builder.
+func (tr *TagRestore) Where(ps ...predicate.Tag) *TagRestore {
+	tr.predicates = append(tr.predicates, ps...)
+	return tr
+}
+
+// Exec executes the restore query and returns how many vertices were restored.
+// Only rows that are currently soft-deleted are affected. If lifecycle callbacks
+// are registered for restores, they run with the restore in a transaction. The
+// restore fails with a BypassDeniedError if the soft-delete policy denies it.
+func (tr *TagRestore) Exec(ctx context.Context) (int, error) {
+	if err := tr.authorizeBypass(ctx, TypeTag, BypassRestore); err != nil {
+		return 0, err
+	}
+	if !tr.tagLifecycle().has(beforeRestore, afterRestore) {
+		update := NewTagClient(tr.config).Update().
+			Where(tr.predicates...).
+			Where(tagDeleted())
+		update.mutation.markLive()
+		return update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+	}
+	return softDeleteTx(ctx, tr.config, func(cfg config) (int, error) {
+		// The filter applies to the restored rows only, so the edge predicates match
+		// the same neighbors as without callbacks.
+		ids, err := NewTagClient(cfg).Query().
+			withDeletedTimeFilter(DeletedTimeOnly).
+			Where(tr.predicates...).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		if err := cfg.tagLifecycle().run(ctx, cfg, beforeRestore, ids); err != nil {
+			return 0, err
+		}
+		var n int
+		for _, chunk := range tagIDChunks(ids) {
+			update := NewTagClient(cfg).Update().
+				Where(tag.IDIn(chunk...), tagDeleted())
+			update.mutation.markLive()
+			affected, err := update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		if err := cfg.tagLifecycle().run(ctx, cfg, afterRestore, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tr *TagRestore) ExecX(ctx context.Context) int {
+	n, err := tr.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// TagRestoreOne is the builder for restoring a single soft-deleted Tag entity.
+type TagRestoreOne struct {
+	tr *TagRestore
+}
+
+// Exec executes the restore query.
+func (tro *TagRestoreOne) Exec(ctx context.Context) error {
+	n, err := tro.tr.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tro *TagRestoreOne) ExecX(ctx context.Context) {
+	if err := tro.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// HardDelete returns a builder that removes Tag entities for good,
+// instead of soft-deleting them. The bypass applies to this operation only,
+// and not to the operations that hooks execute with the same context.
+func (c *TagClient) HardDelete() *TagHardDelete {
+	return &TagHardDelete{c.Delete()}
+}
+
+// HardDeleteOne returns a builder that removes the given entity for good.
+func (c *TagClient) HardDeleteOne(t *Tag) *TagHardDeleteOne {
+	return c.HardDeleteOneID(t.ID)
+}
+
+// HardDeleteOneID returns a builder that removes the entity with the given id for good.
+func (c *TagClient) HardDeleteOneID(id int) *TagHardDeleteOne {
+	builder := c.HardDelete().Where(tag.ID(id))
+	builder.delete.mutation.id = &id
+	builder.delete.mutation.op = OpDeleteOne
+	return &TagHardDeleteOne{builder}
+}
+
+// TagHardDelete is the builder for removing Tag entities for good.
+type TagHardDelete struct {
+	delete *TagDelete
+}
+
+// Where appends a list predicates to the TagHardDelete builder.
+func (thd *TagHardDelete) Where(ps ...predicate.Tag) *TagHardDelete {
+	thd.delete.Where(ps...)
+	return thd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (thd *TagHardDelete) Exec(ctx context.Context) (int, error) {
+	return thd.delete.Exec(newHardDeleteMutationContext(ctx, thd.delete.mutation))
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thd *TagHardDelete) ExecX(ctx context.Context) int {
+	n, err := thd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// TagHardDeleteOne is the builder for removing a single Tag entity for good.
+type TagHardDeleteOne struct {
+	thd *TagHardDelete
+}
+
+// Exec executes the deletion query.
+func (thdo *TagHardDeleteOne) Exec(ctx context.Context) error {
+	n, err := thdo.thd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thdo *TagHardDeleteOne) ExecX(ctx context.Context) {
+	if err := thdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Purge returns a builder for permanently removing soft-deleted Tag entities.
+func (c *TagClient) Purge() *TagPurge {
+	return &TagPurge{config: c.config}
+}
+
+// TagPurge is the builder for permanently removing soft-deleted Tag entities.
+type TagPurge struct {
+	config
+	predicates    []predicate.Tag
+	before        time.Time
+	batchSize     int
+	rowsPerSecond int
+}
+
+// Where appends a list predicates to the TagPurge builder.
+func (tp *TagPurge) Where(ps ...predicate.Tag) *TagPurge {
+	tp.predicates = append(tp.predicates, ps...)
+	return tp
+}
+
+// Before sets the cutoff of the purge. Only rows soft-deleted before t are removed.
+func (tp *TagPurge) Before(t time.Time) *TagPurge {
+	tp.before = t
+	return tp
+}
+
+// BatchSize sets the maximum number of rows removed by a single statement.
+func (tp *TagPurge) BatchSize(n int) *TagPurge {
+	tp.batchSize = n
+	return tp
+}
+
+// RowsPerSecond limits the rate at which rows are removed. Zero means no limit.
+func (tp *TagPurge) RowsPerSecond(n int) *TagPurge {
+	tp.rowsPerSecond = n
+	return tp
+}
+
+// Exec executes the purge and returns how many rows were removed. Each batch
+// is removed in a transaction, along with its lifecycle callbacks. The purge
+// fails with a BypassDeniedError if the soft-delete policy denies it.
+func (tp *TagPurge) Exec(ctx context.Context) (int, error) {
+	return 0, &NoDeletionTimeError{Type: TypeTag}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tp *TagPurge) ExecX(ctx context.Context) int {
+	n, err := tp.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// todoNotDeleted returns the predicate matching the Todo rows that are not soft-deleted.
+func todoNotDeleted() predicate.Todo {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(s.C("deleted_time") + " " + predicate.TodoLiveCondition))
+	}
+}
+
+// todoDeleted returns the predicate matching the soft-deleted Todo rows.
+func todoDeleted() predicate.Todo {
+	return func(s *sql.Selector) {
+		s.Where(sql.Not(sql.ExprP(s.C("deleted_time") + " " + predicate.TodoLiveCondition)))
+	}
+}
+
+// todoDeletedBefore returns the predicate matching the Todo rows soft-deleted before t.
+func todoDeletedBefore(t time.Time) predicate.Todo {
+	return todo.And(todoDeleted(), todo.DeletedTimeLT(t))
+}
+
+// todoIDChunks splits the given ids into chunks of at most softDeleteChunkSize ids.
+func todoIDChunks(ids []int) [][]int {
+	chunks := make([][]int, 0, (len(ids)+softDeleteChunkSize-1)/softDeleteChunkSize)
+	for len(ids) > softDeleteChunkSize {
+		chunks = append(chunks, ids[:softDeleteChunkSize])
+		ids = ids[softDeleteChunkSize:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// deletedTime returns the time recorded in the soft-delete field of the Todo.
+func (t *Todo) deletedTime() time.Time {
+	return t.DeletedTime
+}
+
+// markDeleted sets the soft-delete fields of the mutation to mark the rows deleted at t by the given batch.
+func (m *TodoMutation) markDeleted(ctx context.Context, t time.Time, batch string) {
+	m.SetDeletedTime(t)
+	m.SetDeletedBatch(batch)
+}
+
+// markLive resets the soft-delete fields of the mutation to the values of live rows.
+func (m *TodoMutation) markLive() {
+	m.ClearDeletedTime()
+	m.ClearDeletedBatch()
+}
+
+// SetDeletedTime soft-deletes the Todo entities with the given ids at time t,
+// and returns how many of them were deleted. It runs the soft delete of the delete
+// builders, including their hooks, edge actions and lifecycle callbacks, and the
+// cascaded entities are stamped with t as well.
+func (c *TodoClient) SetDeletedTime(ctx context.Context, t time.Time, ids ...int) (int, error) {
+	ctx, _ = newSoftDeleteCascadeContext(ctx, t)
+	d := c.Delete().Where(todo.IDIn(ids...))
+	return d.Exec(NewSoftDeleteContext(ctx, d.mutation))
+}
+
+// DeletedError returns a DeletedError if the Todo with the given id exists
+// but is soft-deleted, and nil if it is live or missing. It tells the two apart
+// after a NotFoundError.
+func (c *TodoClient) DeletedError(ctx context.Context, id int) error {
+	t, err := c.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(todo.ID(id)).Only(ctx)
+	switch {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return &DeletedError{Type: TypeTodo, ID: id, DeletedTime: t.deletedTime(), label: todo.Label}
+}
+
+// Restore returns a restore builder for Todo.
+func (c *TodoClient) Restore() *TodoRestore {
+	return &TodoRestore{config: c.config}
+}
+
+// RestoreOne returns a restore builder for the given entity.
+func (c *TodoClient) RestoreOne(t *Todo) *TodoRestoreOne {
+	return c.RestoreOneID(t.ID)
+}
+
+// RestoreOneID returns a restore builder for the given id.
+func (c *TodoClient) RestoreOneID(id int) *TodoRestoreOne {
+	builder := c.Restore().Where(todo.ID(id))
+	return &TodoRestoreOne{builder}
+}
+
+// TodoRestore is the builder for restoring soft-deleted Todo entities.
+type TodoRestore struct {
+	config
+	predicates []predicate.Todo
+}
+
+// Where appends a list predicates to the TodoRestore builder.
+func (tr *TodoRestore) Where(ps ...predicate.Todo) *TodoRestore {
+	tr.predicates = append(tr.predicates, ps...)
+	return tr
+}
+
+// Exec executes the restore query and returns how many vertices were restored.
+// Only rows that are currently soft-deleted are affected. If lifecycle callbacks
+// are registered for restores, they run with the restore in a transaction. The
+// restore fails with a BypassDeniedError if the soft-delete policy denies it.
+func (tr *TodoRestore) Exec(ctx context.Context) (int, error) {
+	if err := tr.authorizeBypass(ctx, TypeTodo, BypassRestore); err != nil {
+		return 0, err
+	}
+	if !tr.todoLifecycle().has(beforeRestore, afterRestore) {
+		update := NewTodoClient(tr.config).Update().
+			Where(tr.predicates...).
+			Where(todoDeleted())
+		update.mutation.markLive()
+		return update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+	}
+	return softDeleteTx(ctx, tr.config, func(cfg config) (int, error) {
+		// The filter applies to the restored rows only, so the edge predicates match
+		// the same neighbors as without callbacks.
+		ids, err := NewTodoClient(cfg).Query().
+			withDeletedTimeFilter(DeletedTimeOnly).
+			Where(tr.predicates...).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		if err := cfg.todoLifecycle().run(ctx, cfg, beforeRestore, ids); err != nil {
+			return 0, err
+		}
+		var n int
+		for _, chunk := range todoIDChunks(ids) {
+			update := NewTodoClient(cfg).Update().
+				Where(todo.IDIn(chunk...), todoDeleted())
+			update.mutation.markLive()
+			affected, err := update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		if err := cfg.todoLifecycle().run(ctx, cfg, afterRestore, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tr *TodoRestore) ExecX(ctx context.Context) int {
+	n, err := tr.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// TodoRestoreOne is the builder for restoring a single soft-deleted Todo entity.
+type TodoRestoreOne struct {
+	tr *TodoRestore
+}
+
+// Exec executes the restore query.
+func (tro *TodoRestoreOne) Exec(ctx context.Context) error {
+	n, err := tro.tr.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todo.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tro *TodoRestoreOne) ExecX(ctx context.Context) {
+	if err := tro.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// HardDelete returns a builder that removes Todo entities for good,
+// instead of soft-deleting them. The bypass applies to this operation only,
+// and not to the operations that hooks execute with the same context.
+func (c *TodoClient) HardDelete() *TodoHardDelete {
+	return &TodoHardDelete{c.Delete()}
+}
+
+// HardDeleteOne returns a builder that removes the given entity for good.
+func (c *TodoClient) HardDeleteOne(t *Todo) *TodoHardDeleteOne {
+	return c.HardDeleteOneID(t.ID)
+}
+
+// HardDeleteOneID returns a builder that removes the entity with the given id for good.
+func (c *TodoClient) HardDeleteOneID(id int) *TodoHardDeleteOne {
+	builder := c.HardDelete().Where(todo.ID(id))
+	builder.delete.mutation.id = &id
+	builder.delete.mutation.op = OpDeleteOne
+	return &TodoHardDeleteOne{builder}
+}
+
+// TodoHardDelete is the builder for removing Todo entities for good.
+type TodoHardDelete struct {
+	delete *TodoDelete
+}
+
+// Where appends a list predicates to the TodoHardDelete builder.
+func (thd *TodoHardDelete) Where(ps ...predicate.Todo) *TodoHardDelete {
+	thd.delete.Where(ps...)
+	return thd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (thd *TodoHardDelete) Exec(ctx context.Context) (int, error) {
+	return thd.delete.Exec(newHardDeleteMutationContext(ctx, thd.delete.mutation))
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thd *TodoHardDelete) ExecX(ctx context.Context) int {
+	n, err := thd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// TodoHardDeleteOne is the builder for removing a single Todo entity for good.
+type TodoHardDeleteOne struct {
+	thd *TodoHardDelete
+}
+
+// Exec executes the deletion query.
+func (thdo *TodoHardDeleteOne) Exec(ctx context.Context) error {
+	n, err := thdo.thd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{todo.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (thdo *TodoHardDeleteOne) ExecX(ctx context.Context) {
+	if err := thdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Purge returns a builder for permanently removing soft-deleted Todo entities.
+func (c *TodoClient) Purge() *TodoPurge {
+	return &TodoPurge{config: c.config}
+}
+
+// TodoPurge is the builder for permanently removing soft-deleted Todo entities.
+type TodoPurge struct {
+	config
+	predicates    []predicate.Todo
+	before        time.Time
+	batchSize     int
+	rowsPerSecond int
+}
+
+// Where appends a list predicates to the TodoPurge builder.
+func (tp *TodoPurge) Where(ps ...predicate.Todo) *TodoPurge {
+	tp.predicates = append(tp.predicates, ps...)
+	return tp
+}
+
+// Before sets the cutoff of the purge. Only rows soft-deleted before t are removed.
+func (tp *TodoPurge) Before(t time.Time) *TodoPurge {
+	tp.before = t
+	return tp
+}
+
+// BatchSize sets the maximum number of rows removed by a single statement.
+func (tp *TodoPurge) BatchSize(n int) *TodoPurge {
+	tp.batchSize = n
+	return tp
+}
+
+// RowsPerSecond limits the rate at which rows are removed. Zero means no limit.
+func (tp *TodoPurge) RowsPerSecond(n int) *TodoPurge {
+	tp.rowsPerSecond = n
+	return tp
+}
+
+// Exec executes the purge and returns how many rows were removed. Each batch
+// is removed in a transaction, along with its lifecycle callbacks. The purge
+// fails with a BypassDeniedError if the soft-delete policy denies it.
+func (tp *TodoPurge) Exec(ctx context.Context) (int, error) {
+	if tp.before.IsZero() {
+		return 0, &MissingCutoffError{Type: TypeTodo}
+	}
+	if err := tp.authorizeBypass(ctx, TypeTodo, BypassPurge); err != nil {
+		return 0, err
+	}
+	size := tp.batchSize
+	if size <= 0 {
+		size = DefaultPurgeBatchSize
+	}
+	var total int
+	for {
+		ids, err := NewTodoClient(tp.config).Query().
+			withDeletedTimeFilter(DeletedTimeInclude).
+			Where(tp.predicates...).
+			Where(todoDeletedBefore(tp.before)).
+			Limit(size).
+			IDs(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		n, err := softDeleteTx(ctx, tp.config, func(cfg config) (int, error) {
+			if err := cfg.todoLifecycle().run(ctx, cfg, beforePurge, ids); err != nil {
+				return 0, err
+			}
+			// The builder is executed without its hooks, as the rows are removed for good.
+			purge := NewTodoClient(cfg).Delete().
+				Where(todo.IDIn(ids...), todoDeletedBefore(tp.before))
+			n, err := purge.sqlExec(newPurgeMutationContext(ctx, purge.mutation))
+			if err != nil {
+				return 0, err
+			}
+			if err := cfg.todoLifecycle().run(ctx, cfg, afterPurge, ids); err != nil {
+				return 0, err
+			}
+			return n, nil
+		})
+		total += n
+		if err != nil || len(ids) < size {
+			return total, err
+		}
+		if tp.rowsPerSecond > 0 {
+			select {
+			case <-ctx.Done():
+				return total, ctx.Err()
+			case <-time.After(time.Duration(n) * time.Second / time.Duration(tp.rowsPerSecond)):
+			}
+		}
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tp *TodoPurge) ExecX(ctx context.Context) int {
+	n, err := tp.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// userNotDeleted returns the predicate matching the User rows that are not soft-deleted.
+func userNotDeleted() predicate.User {
+	return func(s *sql.Selector) {
+		s.Where(sql.ExprP(s.C("deleted_time") + " " + predicate.UserLiveCondition))
+	}
+}
+
+// userDeleted returns the predicate matching the soft-deleted User rows.
+func userDeleted() predicate.User {
+	return func(s *sql.Selector) {
+		s.Where(sql.Not(sql.ExprP(s.C("deleted_time") + " " + predicate.UserLiveCondition)))
+	}
+}
+
+// userDeletedBefore returns the predicate matching the User rows soft-deleted before t.
+func userDeletedBefore(t time.Time) predicate.User {
+	return user.And(userDeleted(), user.DeletedTimeLT(t))
+}
+
+// userIDChunks splits the given ids into chunks of at most softDeleteChunkSize ids.
+func userIDChunks(ids []int) [][]int {
+	chunks := make([][]int, 0, (len(ids)+softDeleteChunkSize-1)/softDeleteChunkSize)
+	for len(ids) > softDeleteChunkSize {
+		chunks = append(chunks, ids[:softDeleteChunkSize])
+		ids = ids[softDeleteChunkSize:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// deletedTime returns the time recorded in the soft-delete field of the User.
+func (u *User) deletedTime() time.Time {
+	return u.DeletedTime
+}
+
+// markDeleted sets the soft-delete fields of the mutation to mark the rows deleted at t by the given batch.
+func (m *UserMutation) markDeleted(ctx context.Context, t time.Time, batch string) {
+	m.SetDeletedTime(t)
+	m.SetDeletedBatch(batch)
+	info := DeletionInfoFromContext(ctx)
+	if info.By != "" {
+		m.SetDeletedBy(info.By)
+	}
+	if info.Reason != "" {
+		m.SetDeletedReason(info.Reason)
+	}
+}
+
+// markLive resets the soft-delete fields of the mutation to the values of live rows.
+func (m *UserMutation) markLive() {
+	m.ClearDeletedTime()
+	m.ClearDeletedBatch()
+	m.ClearDeletedBy()
+	m.ClearDeletedReason()
+}
+
+// SetDeletedTime soft-deletes the User entities with the given ids at time t,
+// and returns how many of them were deleted. It runs the soft delete of the delete
+// builders, including their hooks, edge actions and lifecycle callbacks, and the
+// cascaded entities are stamped with t as well.
+func (c *UserClient) SetDeletedTime(ctx context.Context, t time.Time, ids ...int) (int, error) {
+	ctx, _ = newSoftDeleteCascadeContext(ctx, t)
+	d := c.Delete().Where(user.IDIn(ids...))
+	return d.Exec(NewSoftDeleteContext(ctx, d.mutation))
+}
+
+// DeletedError returns a DeletedError if the User with the given id exists
+// but is soft-deleted, and nil if it is live or missing. It tells the two apart
+// after a NotFoundError.
+func (c *UserClient) DeletedError(ctx context.Context, id int) error {
+	u, err := c.Query().withDeletedTimeFilter(DeletedTimeOnly).Where(user.ID(id)).Only(ctx)
+	switch {
+	case IsNotFound(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	return &DeletedError{Type: TypeUser, ID: id, DeletedTime: u.deletedTime(), label: user.Label}
+}
+
+// Restore returns a restore builder for User.
+func (c *UserClient) Restore() *UserRestore {
+	return &UserRestore{config: c.config}
+}
+
+// RestoreOne returns a restore builder for the given entity.
+func (c *UserClient) RestoreOne(u *User) *UserRestoreOne {
+	return c.RestoreOneID(u.ID)
+}
+
+// RestoreOneID returns a restore builder for the given id.
+func (c *UserClient) RestoreOneID(id int) *UserRestoreOne {
+	builder := c.Restore().Where(user.ID(id))
+	return &UserRestoreOne{builder}
+}
+
+// UserRestore is the builder for restoring soft-deleted User entities.
+type UserRestore struct {
+	config
+	predicates []predicate.User
+}
+
+// Where appends a list predicates to the UserRestore builder.
+func (ur *UserRestore) Where(ps ...predicate.User) *UserRestore {
+	ur.predicates = append(ur.predicates, ps...)
+	return ur
+}
+
+// Exec executes the restore query and returns how many vertices were restored.
+// Only rows that are currently soft-deleted are affected. If lifecycle callbacks
+// are registered for restores, they run with the restore in a transaction. The
+// restore fails with a BypassDeniedError if the soft-delete policy denies it.
+func (ur *UserRestore) Exec(ctx context.Context) (int, error) {
+	if err := ur.authorizeBypass(ctx, TypeUser, BypassRestore); err != nil {
+		return 0, err
+	}
+	if !ur.userLifecycle().has(beforeRestore, afterRestore) {
+		update := NewUserClient(ur.config).Update().
+			Where(ur.predicates...).
+			Where(userDeleted())
+		update.mutation.markLive()
+		return update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+	}
+	return softDeleteTx(ctx, ur.config, func(cfg config) (int, error) {
+		// The filter applies to the restored rows only, so the edge predicates match
+		// the same neighbors as without callbacks.
+		ids, err := NewUserClient(cfg).Query().
+			withDeletedTimeFilter(DeletedTimeOnly).
+			Where(ur.predicates...).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return 0, err
+		}
+		if err := cfg.userLifecycle().run(ctx, cfg, beforeRestore, ids); err != nil {
+			return 0, err
+		}
+		var n int
+		for _, chunk := range userIDChunks(ids) {
+			update := NewUserClient(cfg).Update().
+				Where(user.IDIn(chunk...), userDeleted())
+			update.mutation.markLive()
+			affected, err := update.Save(newUpdateDeletedMutationContext(ctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		if err := cfg.userLifecycle().run(ctx, cfg, afterRestore, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ur *UserRestore) ExecX(ctx context.Context) int {
+	n, err := ur.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// UserRestoreOne is the builder for restoring a single soft-deleted User entity.
+type UserRestoreOne struct {
+	ur *UserRestore
+}
+
+// Exec executes the restore query.
+func (uro *UserRestoreOne) Exec(ctx context.Context) error {
+	n, err := uro.ur.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{user.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uro *UserRestoreOne) ExecX(ctx context.Context) {
+	if err := uro.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// HardDelete returns a builder that removes User entities for good,
+// instead of soft-deleting them. The bypass applies to this operation only,
+// and not to the operations that hooks execute with the same context.
+func (c *UserClient) HardDelete() *UserHardDelete {
+	return &UserHardDelete{c.Delete()}
+}
+
+// HardDeleteOne returns a builder that removes the given entity for good.
+func (c *UserClient) HardDeleteOne(u *User) *UserHardDeleteOne {
+	return c.HardDeleteOneID(u.ID)
+}
+
+// HardDeleteOneID returns a builder that removes the entity with the given id for good.
+func (c *UserClient) HardDeleteOneID(id int) *UserHardDeleteOne {
+	builder := c.HardDelete().Where(user.ID(id))
+	builder.delete.mutation.id = &id
+	builder.delete.mutation.op = OpDeleteOne
+	return &UserHardDeleteOne{builder}
+}
+
+// UserHardDelete is the builder for removing User entities for good.
+type UserHardDelete struct {
+	delete *UserDelete
+}
+
+// Where appends a list predicates to the UserHardDelete builder.
+func (uhd *UserHardDelete) Where(ps ...predicate.User) *UserHardDelete {
+	uhd.delete.Where(ps...)
+	return uhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uhd *UserHardDelete) Exec(ctx context.Context) (int, error) {
+	return uhd.delete.Exec(newHardDeleteMutationContext(ctx, uhd.delete.mutation))
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhd *UserHardDelete) ExecX(ctx context.Context) int {
+	n, err := uhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// UserHardDeleteOne is the builder for removing a single User entity for good.
+type UserHardDeleteOne struct {
+	uhd *UserHardDelete
+}
+
+// Exec executes the deletion query.
+func (uhdo *UserHardDeleteOne) Exec(ctx context.Context) error {
+	n, err := uhdo.uhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{user.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uhdo *UserHardDeleteOne) ExecX(ctx context.Context) {
+	if err := uhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Purge returns a builder for permanently removing soft-deleted User entities.
+func (c *UserClient) Purge() *UserPurge {
+	return &UserPurge{config: c.config}
+}
+
+// UserPurge is the builder for permanently removing soft-deleted User entities.
+type UserPurge struct {
+	config
+	predicates    []predicate.User
+	before        time.Time
+	batchSize     int
+	rowsPerSecond int
+}
+
+// Where appends a list predicates to the UserPurge builder.
+func (up *UserPurge) Where(ps ...predicate.User) *UserPurge {
+	up.predicates = append(up.predicates, ps...)
+	return up
+}
+
+// Before sets the cutoff of the purge. Only rows soft-deleted before t are removed.
+func (up *UserPurge) Before(t time.Time) *UserPurge {
+	up.before = t
+	return up
+}
+
+// BatchSize sets the maximum number of rows removed by a single statement.
+func (up *UserPurge) BatchSize(n int) *UserPurge {
+	up.batchSize = n
+	return up
+}
+
+// RowsPerSecond limits the rate at which rows are removed. Zero means no limit.
+func (up *UserPurge) RowsPerSecond(n int) *UserPurge {
+	up.rowsPerSecond = n
+	return up
+}
+
+// Exec executes the purge and returns how many rows were removed. Each batch
+// is removed in a transaction, along with its lifecycle callbacks. The purge
+// fails with a BypassDeniedError if the soft-delete policy denies it.
+func (up *UserPurge) Exec(ctx context.Context) (int, error) {
+	if up.before.IsZero() {
+		return 0, &MissingCutoffError{Type: TypeUser}
+	}
+	if err := up.authorizeBypass(ctx, TypeUser, BypassPurge); err != nil {
+		return 0, err
+	}
+	size := up.batchSize
+	if size <= 0 {
+		size = DefaultPurgeBatchSize
+	}
+	var total int
+	for {
+		ids, err := NewUserClient(up.config).Query().
+			withDeletedTimeFilter(DeletedTimeInclude).
+			Where(up.predicates...).
+			Where(userDeletedBefore(up.before)).
+			Limit(size).
+			IDs(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(ids) == 0 {
+			return total, nil
+		}
+		n, err := softDeleteTx(ctx, up.config, func(cfg config) (int, error) {
+			if err := cfg.userLifecycle().run(ctx, cfg, beforePurge, ids); err != nil {
+				return 0, err
+			}
+			// The builder is executed without its hooks, as the rows are removed for good.
+			purge := NewUserClient(cfg).Delete().
+				Where(user.IDIn(ids...), userDeletedBefore(up.before))
+			n, err := purge.sqlExec(newPurgeMutationContext(ctx, purge.mutation))
+			if err != nil {
+				return 0, err
+			}
+			if err := cfg.userLifecycle().run(ctx, cfg, afterPurge, ids); err != nil {
+				return 0, err
+			}
+			return n, nil
+		})
+		total += n
+		if err != nil || len(ids) < size {
+			return total, err
+		}
+		if up.rowsPerSecond > 0 {
+			select {
+			case <-ctx.Done():
+				return total, ctx.Err()
+			case <-time.After(time.Duration(n) * time.Second / time.Duration(up.rowsPerSecond)):
+			}
+		}
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (up *UserPurge) ExecX(ctx context.Context) int {
+	n, err := up.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
 }
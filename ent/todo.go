@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"entgo.io/bug/ent/todo"
+	"entgo.io/bug/ent/user"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -18,8 +19,77 @@ type Todo struct {
 	ID int `json:"id,omitempty"`
 	// DeletedTime holds the value of the "deleted_time" field.
 	DeletedTime time.Time `json:"deleted_time,omitempty"`
+	// DeletedBatch holds the value of the "deleted_batch" field.
+	DeletedBatch string `json:"deleted_batch,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID int `json:"owner_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoQuery when eager-loading is set.
+	Edges         TodoEdges `json:"edges"`
+	todo_children *int
+}
+
+// TodoEdges holds the relations/edges for other nodes in the graph.
+type TodoEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Todo `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Todo `json:"children,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) OwnerOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// The edge owner was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ParentOrErr() (*Todo, error) {
+	if e.loadedTypes[1] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todo.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ChildrenOrErr() ([]*Todo, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -27,12 +97,14 @@ func (*Todo) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case todo.FieldID:
+		case todo.FieldID, todo.FieldOwnerID:
 			values[i] = new(sql.NullInt64)
-		case todo.FieldName:
+		case todo.FieldDeletedBatch, todo.FieldName:
 			values[i] = new(sql.NullString)
 		case todo.FieldDeletedTime:
 			values[i] = new(sql.NullTime)
+		case todo.ForeignKeys[0]: // todo_children
+			values[i] = new(sql.NullInt64)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type Todo", columns[i])
 		}
@@ -60,17 +132,56 @@ func (t *Todo) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				t.DeletedTime = value.Time
 			}
+		case todo.FieldDeletedBatch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_batch", values[i])
+			} else if value.Valid {
+				t.DeletedBatch = value.String
+			}
 		case todo.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
 				t.Name = value.String
 			}
+		case todo.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				t.OwnerID = int(value.Int64)
+			}
+		case todo.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field todo_children", value)
+			} else if value.Valid {
+				t.todo_children = new(int)
+				*t.todo_children = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// QueryOwner queries the "owner" edge of the Todo entity.
+func (t *Todo) QueryOwner() *UserQuery {
+	return (&TodoClient{config: t.config}).QueryOwner(t)
+}
+
+// QueryParent queries the "parent" edge of the Todo entity.
+func (t *Todo) QueryParent() *TodoQuery {
+	return (&TodoClient{config: t.config}).QueryParent(t)
+}
+
+// QueryChildren queries the "children" edge of the Todo entity.
+func (t *Todo) QueryChildren() *TodoQuery {
+	return (&TodoClient{config: t.config}).QueryChildren(t)
+}
+
+// QueryTags queries the "tags" edge of the Todo entity.
+func (t *Todo) QueryTags() *TagQuery {
+	return (&TodoClient{config: t.config}).QueryTags(t)
+}
+
 // Update returns a builder for updating this Todo.
 // Note that you need to call Todo.Unwrap() before calling this method if this Todo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -96,8 +207,12 @@ func (t *Todo) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
 	builder.WriteString(", deleted_time=")
 	builder.WriteString(t.DeletedTime.Format(time.ANSIC))
+	builder.WriteString(", deleted_batch=")
+	builder.WriteString(t.DeletedBatch)
 	builder.WriteString(", name=")
 	builder.WriteString(t.Name)
+	builder.WriteString(", owner_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.OwnerID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/bug/ent/predicate"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -99,6 +100,13 @@ func DeletedTime(v time.Time) predicate.Todo {
 	})
 }
 
+// DeletedBatch applies equality check predicate on the "deleted_batch" field. It's identical to DeletedBatchEQ.
+func DeletedBatch(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedBatch), v))
+	})
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Todo {
 	return predicate.Todo(func(s *sql.Selector) {
@@ -106,6 +114,13 @@ func Name(v string) predicate.Todo {
 	})
 }
 
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v int) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerID), v))
+	})
+}
+
 // DeletedTimeEQ applies the EQ predicate on the "deleted_time" field.
 func DeletedTimeEQ(v time.Time) predicate.Todo {
 	return predicate.Todo(func(s *sql.Selector) {
@@ -196,6 +211,131 @@ func DeletedTimeNotNil() predicate.Todo {
 	})
 }
 
+// DeletedBatchEQ applies the EQ predicate on the "deleted_batch" field.
+func DeletedBatchEQ(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchNEQ applies the NEQ predicate on the "deleted_batch" field.
+func DeletedBatchNEQ(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchIn applies the In predicate on the "deleted_batch" field.
+func DeletedBatchIn(vs ...string) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedBatch), v...))
+	})
+}
+
+// DeletedBatchNotIn applies the NotIn predicate on the "deleted_batch" field.
+func DeletedBatchNotIn(vs ...string) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedBatch), v...))
+	})
+}
+
+// DeletedBatchGT applies the GT predicate on the "deleted_batch" field.
+func DeletedBatchGT(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchGTE applies the GTE predicate on the "deleted_batch" field.
+func DeletedBatchGTE(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchLT applies the LT predicate on the "deleted_batch" field.
+func DeletedBatchLT(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchLTE applies the LTE predicate on the "deleted_batch" field.
+func DeletedBatchLTE(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchContains applies the Contains predicate on the "deleted_batch" field.
+func DeletedBatchContains(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchHasPrefix applies the HasPrefix predicate on the "deleted_batch" field.
+func DeletedBatchHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchHasSuffix applies the HasSuffix predicate on the "deleted_batch" field.
+func DeletedBatchHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchIsNil applies the IsNil predicate on the "deleted_batch" field.
+func DeletedBatchIsNil() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedBatch)))
+	})
+}
+
+// DeletedBatchNotNil applies the NotNil predicate on the "deleted_batch" field.
+func DeletedBatchNotNil() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedBatch)))
+	})
+}
+
+// DeletedBatchEqualFold applies the EqualFold predicate on the "deleted_batch" field.
+func DeletedBatchEqualFold(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchContainsFold applies the ContainsFold predicate on the "deleted_batch" field.
+func DeletedBatchContainsFold(v string) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeletedBatch), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Todo {
 	return predicate.Todo(func(s *sql.Selector) {
@@ -307,6 +447,232 @@ func NameContainsFold(v string) predicate.Todo {
 	})
 }
 
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v int) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v int) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...int) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOwnerID), v...))
+	})
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...int) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOwnerID), v...))
+	})
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOwnerID)))
+	})
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOwnerID)))
+	})
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OwnerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		predicate.CollectDeletedTimeType(s, "User")
+		if predicate.DeletedTimeFilterFromContext(s.Context()) != predicate.DeletedTimeInclude {
+			// The filter is applied on the neighbors table, not on the table holding the edge.
+			step.To.Table = OwnerInverseTable
+			sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+				predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.UserLiveCondition))
+			})
+			return
+		}
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(OwnerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		preds := append(preds[:len(preds):len(preds)], func(s *sql.Selector) {
+			predicate.CollectDeletedTimeType(s, "User")
+			predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.UserLiveCondition))
+		})
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ParentTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		predicate.CollectDeletedTimeType(s, "Todo")
+		if predicate.DeletedTimeFilterFromContext(s.Context()) != predicate.DeletedTimeInclude {
+			// The filter is applied on the neighbors table, not on the table holding the edge.
+			step.To.Table = Table
+			sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+				predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.TodoLiveCondition))
+			})
+			return
+		}
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		preds := append(preds[:len(preds):len(preds)], func(s *sql.Selector) {
+			predicate.CollectDeletedTimeType(s, "Todo")
+			predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.TodoLiveCondition))
+		})
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ChildrenTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		predicate.CollectDeletedTimeType(s, "Todo")
+		if predicate.DeletedTimeFilterFromContext(s.Context()) != predicate.DeletedTimeInclude {
+			// The filter is applied on the neighbors table, not on the table holding the edge.
+			step.To.Table = Table
+			sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+				predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.TodoLiveCondition))
+			})
+			return
+		}
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		preds := append(preds[:len(preds):len(preds)], func(s *sql.Selector) {
+			predicate.CollectDeletedTimeType(s, "Todo")
+			predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.TodoLiveCondition))
+		})
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		predicate.CollectDeletedTimeType(s, "Tag")
+		if predicate.DeletedTimeFilterFromContext(s.Context()) != predicate.DeletedTimeInclude {
+			// The filter is applied on the neighbors table, not on the table holding the edge.
+			step.To.Table = TagsInverseTable
+			sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+				predicate.FilterDeletedTime(s, sql.ExprP(s.C("is_deleted")+" "+predicate.TagLiveCondition))
+			})
+			return
+		}
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Todo {
+	return predicate.Todo(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TagsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		preds := append(preds[:len(preds):len(preds)], func(s *sql.Selector) {
+			predicate.CollectDeletedTimeType(s, "Tag")
+			predicate.FilterDeletedTime(s, sql.ExprP(s.C("is_deleted")+" "+predicate.TagLiveCondition))
+		})
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Todo) predicate.Todo {
 	return predicate.Todo(func(s *sql.Selector) {
@@ -77,6 +77,30 @@ func (td *TodoDelete) sqlExec(ctx context.Context) (int, error) {
 			},
 		},
 	}
+	if err := td.authorizeDeletedTimeFilter(ctx, todo.Table, func(s *sql.Selector) {
+		for _, p := range td.mutation.predicates {
+			p(s)
+		}
+	}); err != nil {
+		return 0, err
+	}
+	switch DeleteKindOf(ctx, td.mutation) {
+	case DeleteKindSoft:
+		n, err := td.sqlSoftDelete(ctx)
+		if err == nil && n == 0 && td.mutation.Op().Is(OpDeleteOne) {
+			// Report the entities that were soft-deleted before, instead of not found.
+			err = DeletedErrorOf(ctx, td.mutation)
+		}
+		return n, err
+	case DeleteKindHard:
+		// Hard deletes bypass the soft delete, and need the approval of the policy.
+		// The rows of purges are authorized by the purge.
+		if pm, ok := ctx.Value(purgeMutationCtxKey{}).(Mutation); !ok || pm != td.mutation {
+			if err := td.authorizeBypass(ctx, TypeTodo, BypassHardDelete); err != nil {
+				return 0, err
+			}
+		}
+	}
 	if ps := td.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -87,6 +111,74 @@ func (td *TodoDelete) sqlExec(ctx context.Context) (int, error) {
 	return sqlgraph.DeleteNodes(ctx, td.driver, _spec)
 }
 
+// sqlSoftDelete stamps the deleted time on the live rows matched by the builder
+// predicates and applies the soft-delete actions of their edges in the same
+// transaction, along with the lifecycle callbacks. Rows visited earlier in a
+// cascade are skipped.
+func (td *TodoDelete) sqlSoftDelete(ctx context.Context) (int, error) {
+	return softDeleteTx(ctx, td.config, func(cfg config) (int, error) {
+		ctx, sdc := softDeleteCascadeFromContext(ctx)
+		restamp, f := restampDeletedTime(ctx), DeletedTimeExclude
+		if restamp {
+			f = DeletedTimeInclude
+		}
+		// The filter applies to the rows of the builder only, so the edge predicates
+		// match the same neighbors as in the UPDATE statement of the other builders.
+		matched, err := NewTodoClient(cfg).Query().withDeletedTimeFilter(f).Where(td.mutation.predicates...).IDs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		ids := make([]int, 0, len(matched))
+		for _, id := range matched {
+			if sdc.visit(TypeTodo, id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return 0, nil
+		}
+		chunks := todoIDChunks(ids)
+		if err := cfg.todoLifecycle().run(ctx, cfg, beforeSoftDelete, ids); err != nil {
+			return 0, err
+		}
+		var childrenIDs []int
+		for _, chunk := range chunks {
+			neighbors, err := NewTodoClient(cfg).Query().withDeletedTimeFilter(f).Where(todo.IDIn(chunk...)).QueryChildren().withDeletedTimeFilter(f).IDs(ctx)
+			if err != nil {
+				return 0, err
+			}
+			childrenIDs = append(childrenIDs, neighbors...)
+		}
+		var n int
+		for _, chunk := range chunks {
+			update := NewTodoClient(cfg).Update().Where(todo.IDIn(chunk...))
+			update.ClearTags()
+			update.mutation.markDeleted(ctx, sdc.time, sdc.batch)
+			uctx := ctx
+			if restamp {
+				uctx = newUpdateDeletedMutationContext(ctx, update.mutation)
+			}
+			affected, err := update.Save(newSoftDeleteUpdateContext(uctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		// The neighbors are soft-deleted like the rows of the builder, even if the
+		// context requests hard deletes.
+		for _, chunk := range todoIDChunks(childrenIDs) {
+			cascade := NewTodoClient(cfg).Delete().Where(todo.IDIn(chunk...))
+			if _, err := cascade.Exec(NewSoftDeleteContext(ctx, cascade.mutation)); err != nil {
+				return 0, fmt.Errorf("cascade soft delete of edge %q: %w", todo.EdgeChildren, err)
+			}
+		}
+		if err := cfg.todoLifecycle().run(ctx, cfg, afterSoftDelete, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
 // TodoDeleteOne is the builder for deleting a single Todo entity.
 type TodoDeleteOne struct {
 	td *TodoDelete
@@ -4,11 +4,14 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/tag"
 	"entgo.io/bug/ent/todo"
+	"entgo.io/bug/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -23,6 +26,16 @@ type TodoQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Todo
+	// eager-loading edges.
+	withOwner    *UserQuery
+	withParent   *TodoQuery
+	withChildren *TodoQuery
+	withTags     *TagQuery
+	withFKs      bool
+	// deletedTimeFilter overrides the DeletedTimeFilter of the context on the Todo
+	// rows, but not on the neighbors matched by the edge predicates. It is set on the
+	// queries that the generated code runs on its own behalf, and not cloned.
+	deletedTimeFilter *DeletedTimeFilter
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +72,94 @@ func (tq *TodoQuery) Order(o ...OrderFunc) *TodoQuery {
 	return tq
 }
 
+// QueryOwner chains the current query on the "owner" edge.
+func (tq *TodoQuery) QueryOwner() *UserQuery {
+	query := &UserQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todo.OwnerTable, todo.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (tq *TodoQuery) QueryParent() *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, selector),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, todo.ParentTable, todo.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (tq *TodoQuery) QueryChildren() *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, selector),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todo.ChildrenTable, todo.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (tq *TodoQuery) QueryTags() *TagQuery {
+	query := &TagQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(todo.Table, todo.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, todo.TagsTable, todo.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Todo entity from the query.
 // Returns a *NotFoundError when no Todo was found.
 func (tq *TodoQuery) First(ctx context.Context) (*Todo, error) {
@@ -107,6 +208,8 @@ func (tq *TodoQuery) FirstIDX(ctx context.Context) int {
 // Only returns a single Todo entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one Todo entity is found.
 // Returns a *NotFoundError when no Todo entities are found.
+// Returns a *DeletedError, which unwraps to a *NotFoundError, when the query
+// matches no live Todo but a soft-deleted one.
 func (tq *TodoQuery) Only(ctx context.Context) (*Todo, error) {
 	nodes, err := tq.Limit(2).All(ctx)
 	if err != nil {
@@ -116,7 +219,7 @@ func (tq *TodoQuery) Only(ctx context.Context) (*Todo, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{todo.Label}
+		return nil, tq.notFound(ctx)
 	default:
 		return nil, &NotSingularError{todo.Label}
 	}
@@ -134,6 +237,8 @@ func (tq *TodoQuery) OnlyX(ctx context.Context) *Todo {
 // OnlyID is like Only, but returns the only Todo ID in the query.
 // Returns a *NotSingularError when more than one Todo ID is found.
 // Returns a *NotFoundError when no entities are found.
+// Returns a *DeletedError, which unwraps to a *NotFoundError, when the query
+// matches no live Todo but a soft-deleted one.
 func (tq *TodoQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
 	if ids, err = tq.Limit(2).IDs(ctx); err != nil {
@@ -143,7 +248,7 @@ func (tq *TodoQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{todo.Label}
+		err = tq.notFound(ctx)
 	default:
 		err = &NotSingularError{todo.Label}
 	}
@@ -235,11 +340,15 @@ func (tq *TodoQuery) Clone() *TodoQuery {
 		return nil
 	}
 	return &TodoQuery{
-		config:     tq.config,
-		limit:      tq.limit,
-		offset:     tq.offset,
-		order:      append([]OrderFunc{}, tq.order...),
-		predicates: append([]predicate.Todo{}, tq.predicates...),
+		config:       tq.config,
+		limit:        tq.limit,
+		offset:       tq.offset,
+		order:        append([]OrderFunc{}, tq.order...),
+		predicates:   append([]predicate.Todo{}, tq.predicates...),
+		withOwner:    tq.withOwner.Clone(),
+		withParent:   tq.withParent.Clone(),
+		withChildren: tq.withChildren.Clone(),
+		withTags:     tq.withTags.Clone(),
 		// clone intermediate query.
 		sql:    tq.sql.Clone(),
 		path:   tq.path,
@@ -247,6 +356,50 @@ func (tq *TodoQuery) Clone() *TodoQuery {
 	}
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithOwner(opts ...func(*UserQuery)) *TodoQuery {
+	query := &UserQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withOwner = query
+	return tq
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithParent(opts ...func(*TodoQuery)) *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withParent = query
+	return tq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithChildren(opts ...func(*TodoQuery)) *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withChildren = query
+	return tq
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithTags(opts ...func(*TagQuery)) *TodoQuery {
+	query := &TagQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withTags = query
+	return tq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -303,6 +456,9 @@ func (tq *TodoQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if err := tq.authorizeDeletedTime(ctx); err != nil {
+		return err
+	}
 	if tq.path != nil {
 		prev, err := tq.path(ctx)
 		if err != nil {
@@ -315,17 +471,32 @@ func (tq *TodoQuery) prepareQuery(ctx context.Context) error {
 
 func (tq *TodoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Todo, error) {
 	var (
-		nodes = []*Todo{}
-		_spec = tq.querySpec()
+		nodes       = []*Todo{}
+		withFKs     = tq.withFKs
+		_spec       = tq.querySpec()
+		loadedTypes = [4]bool{
+			tq.withOwner != nil,
+			tq.withParent != nil,
+			tq.withChildren != nil,
+			tq.withTags != nil,
+		}
 	)
+	if tq.withOwner != nil || tq.withParent != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, todo.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*Todo).scanValues(nil, columns)
 	}
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &Todo{config: tq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	tq.deletedTimeSpec(ctx, _spec)
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -335,11 +506,126 @@ func (tq *TodoQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Todo, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := tq.withOwner; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Todo)
+		for i := range nodes {
+			fk := nodes[i].OwnerID
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "owner_id" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Owner = n
+			}
+		}
+	}
+
+	if query := tq.withParent; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Todo)
+		for i := range nodes {
+			if nodes[i].todo_children == nil {
+				continue
+			}
+			fk := *nodes[i].todo_children
+			if _, ok := nodeids[fk]; !ok {
+				ids = append(ids, fk)
+			}
+			nodeids[fk] = append(nodeids[fk], nodes[i])
+		}
+		query.Where(todo.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_children" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Parent = n
+			}
+		}
+	}
+
+	if query := tq.withChildren; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Todo)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Children = []*Todo{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Todo(func(s *sql.Selector) {
+			s.Where(sql.InValues(todo.ChildrenColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_children
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_children" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_children" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Children = append(node.Edges.Children, n)
+		}
+	}
+
+	if query := tq.withTags; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Todo)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Tags = []*Tag{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Tag(func(s *sql.Selector) {
+			s.Where(sql.InValues(todo.TagsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.todo_tags
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "todo_tags" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "todo_tags" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Tags = append(node.Edges.Tags, n)
+		}
+	}
+
 	return nodes, nil
 }
 
 func (tq *TodoQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
+	tq.deletedTimeSpec(ctx, _spec)
 	_spec.Node.Columns = tq.fields
 	if len(tq.fields) > 0 {
 		_spec.Unique = tq.unique != nil && *tq.unique
@@ -418,6 +704,9 @@ func (tq *TodoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if tq.unique != nil && *tq.unique {
 		selector.Distinct()
 	}
+	// Edge predicates read the soft-delete filter from the selector context.
+	selector.WithContext(ctx)
+	tq.filterDeletedTime(ctx, selector)
 	for _, p := range tq.predicates {
 		p(selector)
 	}
@@ -435,6 +724,79 @@ func (tq *TodoQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// deletedTimeSpec wraps the predicate of the query spec with the soft-delete filter.
+func (tq *TodoQuery) deletedTimeSpec(ctx context.Context, _spec *sqlgraph.QuerySpec) {
+	ps := _spec.Predicate
+	_spec.Predicate = func(selector *sql.Selector) {
+		if ps != nil {
+			ps(selector)
+		}
+		tq.filterDeletedTime(ctx, selector)
+	}
+}
+
+// withDeletedTimeFilter sets the DeletedTimeFilter of the Todo rows of the query,
+// in place of the one stored in the context.
+func (tq *TodoQuery) withDeletedTimeFilter(f DeletedTimeFilter) *TodoQuery {
+	tq.deletedTimeFilter = &f
+	return tq
+}
+
+// deletedTimeFilterOf returns the DeletedTimeFilter of the Todo rows of the query.
+func (tq *TodoQuery) deletedTimeFilterOf(ctx context.Context) DeletedTimeFilter {
+	if tq.deletedTimeFilter != nil {
+		return *tq.deletedTimeFilter
+	}
+	return DeletedTimeFilterFromContext(ctx)
+}
+
+// filterDeletedTime applies the DeletedTimeFilter of the query on the given selector.
+func (tq *TodoQuery) filterDeletedTime(ctx context.Context, selector *sql.Selector) {
+	switch tq.deletedTimeFilterOf(ctx) {
+	case DeletedTimeInclude:
+	case DeletedTimeOnly:
+		todoDeleted()(selector)
+	default:
+		todoNotDeleted()(selector)
+	}
+}
+
+// notFound returns the error of an Only query that matched no Todo. It is a
+// DeletedError if the query matches a soft-deleted Todo once the
+// soft-delete filter is lifted, and a NotFoundError otherwise.
+func (tq *TodoQuery) notFound(ctx context.Context) error {
+	if tq.deletedTimeFilterOf(ctx) != DeletedTimeExclude {
+		return &NotFoundError{todo.Label}
+	}
+	ids, err := tq.Clone().withDeletedTimeFilter(DeletedTimeInclude).Where(todoDeleted()).Limit(1).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return &NotFoundError{todo.Label}
+	}
+	if err := NewTodoClient(tq.config).DeletedError(ctx, ids[0]); err != nil {
+		return err
+	}
+	return &NotFoundError{todo.Label}
+}
+
+// authorizeDeletedTime authorizes the query of soft-deleted Todo rows and neighbors
+// matched by the edge predicates of the query, and returns a BypassDeniedError
+// if the soft-delete policy denies it.
+func (tq *TodoQuery) authorizeDeletedTime(ctx context.Context) error {
+	var types []string
+	// The filter set on the query by the generated code is not authorized.
+	if tq.deletedTimeFilter == nil {
+		types = append(types, TypeTodo)
+	}
+	return tq.authorizeDeletedTimeFilter(ctx, todo.Table, func(s *sql.Selector) {
+		for _, p := range tq.predicates {
+			p(s)
+		}
+	}, types...)
+}
+
 // TodoGroupBy is the group-by builder for Todo entities.
 type TodoGroupBy struct {
 	config
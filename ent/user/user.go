@@ -13,18 +13,45 @@ const (
 	FieldID = "id"
 	// FieldDeletedTime holds the string denoting the deleted_time field in the database.
 	FieldDeletedTime = "deleted_time"
+	// FieldDeletedBatch holds the string denoting the deleted_batch field in the database.
+	FieldDeletedBatch = "deleted_batch"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
+	// FieldDeletedReason holds the string denoting the deleted_reason field in the database.
+	FieldDeletedReason = "deleted_reason"
 	// FieldAge holds the string denoting the age field in the database.
 	FieldAge = "age"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// EdgeTodos holds the string denoting the todos edge name in mutations.
+	EdgeTodos = "todos"
+	// EdgeSessions holds the string denoting the sessions edge name in mutations.
+	EdgeSessions = "sessions"
 	// Table holds the table name of the user in the database.
 	Table = "users"
+	// TodosTable is the table that holds the todos relation/edge.
+	TodosTable = "todos"
+	// TodosInverseTable is the table name for the Todo entity.
+	// It exists in this package in order to avoid circular dependency with the "todo" package.
+	TodosInverseTable = "todos"
+	// TodosColumn is the table column denoting the todos relation/edge.
+	TodosColumn = "user_todos"
+	// SessionsTable is the table that holds the sessions relation/edge.
+	SessionsTable = "sessions"
+	// SessionsInverseTable is the table name for the Session entity.
+	// It exists in this package in order to avoid circular dependency with the "session" package.
+	SessionsInverseTable = "sessions"
+	// SessionsColumn is the table column denoting the sessions relation/edge.
+	SessionsColumn = "user_sessions"
 )
 
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
 	FieldDeletedTime,
+	FieldDeletedBatch,
+	FieldDeletedBy,
+	FieldDeletedReason,
 	FieldAge,
 	FieldName,
 }
@@ -46,5 +73,5 @@ func ValidColumn(column string) bool {
 //	import _ "entgo.io/bug/ent/runtime"
 //
 var (
-	Hooks [1]ent.Hook
+	Hooks [2]ent.Hook
 )
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/bug/ent/predicate"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -99,6 +100,27 @@ func DeletedTime(v time.Time) predicate.User {
 	})
 }
 
+// DeletedBatch applies equality check predicate on the "deleted_batch" field. It's identical to DeletedBatchEQ.
+func DeletedBatch(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedReason applies equality check predicate on the "deleted_reason" field. It's identical to DeletedReasonEQ.
+func DeletedReason(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedReason), v))
+	})
+}
+
 // Age applies equality check predicate on the "age" field. It's identical to AgeEQ.
 func Age(v int) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -203,6 +225,381 @@ func DeletedTimeNotNil() predicate.User {
 	})
 }
 
+// DeletedBatchEQ applies the EQ predicate on the "deleted_batch" field.
+func DeletedBatchEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchNEQ applies the NEQ predicate on the "deleted_batch" field.
+func DeletedBatchNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchIn applies the In predicate on the "deleted_batch" field.
+func DeletedBatchIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedBatch), v...))
+	})
+}
+
+// DeletedBatchNotIn applies the NotIn predicate on the "deleted_batch" field.
+func DeletedBatchNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedBatch), v...))
+	})
+}
+
+// DeletedBatchGT applies the GT predicate on the "deleted_batch" field.
+func DeletedBatchGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchGTE applies the GTE predicate on the "deleted_batch" field.
+func DeletedBatchGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchLT applies the LT predicate on the "deleted_batch" field.
+func DeletedBatchLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchLTE applies the LTE predicate on the "deleted_batch" field.
+func DeletedBatchLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchContains applies the Contains predicate on the "deleted_batch" field.
+func DeletedBatchContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchHasPrefix applies the HasPrefix predicate on the "deleted_batch" field.
+func DeletedBatchHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchHasSuffix applies the HasSuffix predicate on the "deleted_batch" field.
+func DeletedBatchHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchIsNil applies the IsNil predicate on the "deleted_batch" field.
+func DeletedBatchIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedBatch)))
+	})
+}
+
+// DeletedBatchNotNil applies the NotNil predicate on the "deleted_batch" field.
+func DeletedBatchNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedBatch)))
+	})
+}
+
+// DeletedBatchEqualFold applies the EqualFold predicate on the "deleted_batch" field.
+func DeletedBatchEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedBatchContainsFold applies the ContainsFold predicate on the "deleted_batch" field.
+func DeletedBatchContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeletedBatch), v))
+	})
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedBy), v...))
+	})
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedBy), v...))
+	})
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedBy)))
+	})
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedBy)))
+	})
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeletedBy), v))
+	})
+}
+
+// DeletedReasonEQ applies the EQ predicate on the "deleted_reason" field.
+func DeletedReasonEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonNEQ applies the NEQ predicate on the "deleted_reason" field.
+func DeletedReasonNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonIn applies the In predicate on the "deleted_reason" field.
+func DeletedReasonIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDeletedReason), v...))
+	})
+}
+
+// DeletedReasonNotIn applies the NotIn predicate on the "deleted_reason" field.
+func DeletedReasonNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDeletedReason), v...))
+	})
+}
+
+// DeletedReasonGT applies the GT predicate on the "deleted_reason" field.
+func DeletedReasonGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonGTE applies the GTE predicate on the "deleted_reason" field.
+func DeletedReasonGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonLT applies the LT predicate on the "deleted_reason" field.
+func DeletedReasonLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonLTE applies the LTE predicate on the "deleted_reason" field.
+func DeletedReasonLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonContains applies the Contains predicate on the "deleted_reason" field.
+func DeletedReasonContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonHasPrefix applies the HasPrefix predicate on the "deleted_reason" field.
+func DeletedReasonHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonHasSuffix applies the HasSuffix predicate on the "deleted_reason" field.
+func DeletedReasonHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonIsNil applies the IsNil predicate on the "deleted_reason" field.
+func DeletedReasonIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDeletedReason)))
+	})
+}
+
+// DeletedReasonNotNil applies the NotNil predicate on the "deleted_reason" field.
+func DeletedReasonNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDeletedReason)))
+	})
+}
+
+// DeletedReasonEqualFold applies the EqualFold predicate on the "deleted_reason" field.
+func DeletedReasonEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDeletedReason), v))
+	})
+}
+
+// DeletedReasonContainsFold applies the ContainsFold predicate on the "deleted_reason" field.
+func DeletedReasonContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDeletedReason), v))
+	})
+}
+
 // AgeEQ applies the EQ predicate on the "age" field.
 func AgeEQ(v int) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -390,6 +787,88 @@ func NameContainsFold(v string) predicate.User {
 	})
 }
 
+// HasTodos applies the HasEdge predicate on the "todos" edge.
+func HasTodos() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TodosTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TodosTable, TodosColumn),
+		)
+		predicate.CollectDeletedTimeType(s, "Todo")
+		if predicate.DeletedTimeFilterFromContext(s.Context()) != predicate.DeletedTimeInclude {
+			// The filter is applied on the neighbors table, not on the table holding the edge.
+			step.To.Table = TodosInverseTable
+			sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+				predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.TodoLiveCondition))
+			})
+			return
+		}
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTodosWith applies the HasEdge predicate on the "todos" edge with a given conditions (other predicates).
+func HasTodosWith(preds ...predicate.Todo) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(TodosInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TodosTable, TodosColumn),
+		)
+		preds := append(preds[:len(preds):len(preds)], func(s *sql.Selector) {
+			predicate.CollectDeletedTimeType(s, "Todo")
+			predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_time")+" "+predicate.TodoLiveCondition))
+		})
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSessions applies the HasEdge predicate on the "sessions" edge.
+func HasSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SessionsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		predicate.CollectDeletedTimeType(s, "Session")
+		if predicate.DeletedTimeFilterFromContext(s.Context()) != predicate.DeletedTimeInclude {
+			// The filter is applied on the neighbors table, not on the table holding the edge.
+			step.To.Table = SessionsInverseTable
+			sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+				predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_at")+" "+predicate.SessionLiveCondition))
+			})
+			return
+		}
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSessionsWith applies the HasEdge predicate on the "sessions" edge with a given conditions (other predicates).
+func HasSessionsWith(preds ...predicate.Session) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SessionsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SessionsTable, SessionsColumn),
+		)
+		preds := append(preds[:len(preds):len(preds)], func(s *sql.Selector) {
+			predicate.CollectDeletedTimeType(s, "Session")
+			predicate.FilterDeletedTime(s, sql.ExprP(s.C("deleted_at")+" "+predicate.SessionLiveCondition))
+		})
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/todo"
 	"entgo.io/bug/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -77,6 +78,30 @@ func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 			},
 		},
 	}
+	if err := ud.authorizeDeletedTimeFilter(ctx, user.Table, func(s *sql.Selector) {
+		for _, p := range ud.mutation.predicates {
+			p(s)
+		}
+	}); err != nil {
+		return 0, err
+	}
+	switch DeleteKindOf(ctx, ud.mutation) {
+	case DeleteKindSoft:
+		n, err := ud.sqlSoftDelete(ctx)
+		if err == nil && n == 0 && ud.mutation.Op().Is(OpDeleteOne) {
+			// Report the entities that were soft-deleted before, instead of not found.
+			err = DeletedErrorOf(ctx, ud.mutation)
+		}
+		return n, err
+	case DeleteKindHard:
+		// Hard deletes bypass the soft delete, and need the approval of the policy.
+		// The rows of purges are authorized by the purge.
+		if pm, ok := ctx.Value(purgeMutationCtxKey{}).(Mutation); !ok || pm != ud.mutation {
+			if err := ud.authorizeBypass(ctx, TypeUser, BypassHardDelete); err != nil {
+				return 0, err
+			}
+		}
+	}
 	if ps := ud.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -87,6 +112,87 @@ func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 	return sqlgraph.DeleteNodes(ctx, ud.driver, _spec)
 }
 
+// sqlSoftDelete stamps the deleted time on the live rows matched by the builder
+// predicates and applies the soft-delete actions of their edges in the same
+// transaction, along with the lifecycle callbacks. Rows visited earlier in a
+// cascade are skipped.
+func (ud *UserDelete) sqlSoftDelete(ctx context.Context) (int, error) {
+	return softDeleteTx(ctx, ud.config, func(cfg config) (int, error) {
+		ctx, sdc := softDeleteCascadeFromContext(ctx)
+		restamp, f := restampDeletedTime(ctx), DeletedTimeExclude
+		if restamp {
+			f = DeletedTimeInclude
+		}
+		// The filter applies to the rows of the builder only, so the edge predicates
+		// match the same neighbors as in the UPDATE statement of the other builders.
+		matched, err := NewUserClient(cfg).Query().withDeletedTimeFilter(f).Where(ud.mutation.predicates...).IDs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		ids := make([]int, 0, len(matched))
+		for _, id := range matched {
+			if sdc.visit(TypeUser, id) {
+				ids = append(ids, id)
+			}
+		}
+		if len(ids) == 0 {
+			return 0, nil
+		}
+		chunks := userIDChunks(ids)
+		live := NewDeletedTimeFilterContext(ctx, DeletedTimeExclude)
+		for _, chunk := range chunks {
+			blocking, err := NewUserClient(cfg).Query().Where(user.IDIn(chunk...)).QuerySessions().Limit(restrictedSampleSize).IDs(live)
+			if err != nil {
+				return 0, err
+			}
+			if len(blocking) > 0 {
+				rerr := &RestrictedError{Type: TypeUser, Edge: user.EdgeSessions}
+				for _, id := range blocking {
+					rerr.IDs = append(rerr.IDs, id)
+				}
+				return 0, rerr
+			}
+		}
+		if err := cfg.userLifecycle().run(ctx, cfg, beforeSoftDelete, ids); err != nil {
+			return 0, err
+		}
+		var todosIDs []int
+		for _, chunk := range chunks {
+			neighbors, err := NewUserClient(cfg).Query().withDeletedTimeFilter(f).Where(user.IDIn(chunk...)).QueryTodos().withDeletedTimeFilter(f).IDs(ctx)
+			if err != nil {
+				return 0, err
+			}
+			todosIDs = append(todosIDs, neighbors...)
+		}
+		var n int
+		for _, chunk := range chunks {
+			update := NewUserClient(cfg).Update().Where(user.IDIn(chunk...))
+			update.mutation.markDeleted(ctx, sdc.time, sdc.batch)
+			uctx := ctx
+			if restamp {
+				uctx = newUpdateDeletedMutationContext(ctx, update.mutation)
+			}
+			affected, err := update.Save(newSoftDeleteUpdateContext(uctx, update.mutation))
+			if err != nil {
+				return 0, err
+			}
+			n += affected
+		}
+		// The neighbors are soft-deleted like the rows of the builder, even if the
+		// context requests hard deletes.
+		for _, chunk := range todoIDChunks(todosIDs) {
+			cascade := NewTodoClient(cfg).Delete().Where(todo.IDIn(chunk...))
+			if _, err := cascade.Exec(NewSoftDeleteContext(ctx, cascade.mutation)); err != nil {
+				return 0, fmt.Errorf("cascade soft delete of edge %q: %w", user.EdgeTodos, err)
+			}
+		}
+		if err := cfg.userLifecycle().run(ctx, cfg, afterSoftDelete, ids); err != nil {
+			return 0, err
+		}
+		return n, nil
+	})
+}
+
 // UserDeleteOne is the builder for deleting a single User entity.
 type UserDeleteOne struct {
 	ud *UserDelete
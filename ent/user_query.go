@@ -4,10 +4,13 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/bug/ent/predicate"
+	"entgo.io/bug/ent/session"
+	"entgo.io/bug/ent/todo"
 	"entgo.io/bug/ent/user"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -23,6 +26,13 @@ type UserQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.User
+	// eager-loading edges.
+	withTodos    *TodoQuery
+	withSessions *SessionQuery
+	// deletedTimeFilter overrides the DeletedTimeFilter of the context on the User
+	// rows, but not on the neighbors matched by the edge predicates. It is set on the
+	// queries that the generated code runs on its own behalf, and not cloned.
+	deletedTimeFilter *DeletedTimeFilter
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +69,50 @@ func (uq *UserQuery) Order(o ...OrderFunc) *UserQuery {
 	return uq
 }
 
+// QueryTodos chains the current query on the "todos" edge.
+func (uq *UserQuery) QueryTodos() *TodoQuery {
+	query := &TodoQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(todo.Table, todo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TodosTable, user.TodosColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySessions chains the current query on the "sessions" edge.
+func (uq *UserQuery) QuerySessions() *SessionQuery {
+	query := &SessionQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -107,6 +161,8 @@ func (uq *UserQuery) FirstIDX(ctx context.Context) int {
 // Only returns a single User entity found by the query, ensuring it only returns one.
 // Returns a *NotSingularError when more than one User entity is found.
 // Returns a *NotFoundError when no User entities are found.
+// Returns a *DeletedError, which unwraps to a *NotFoundError, when the query
+// matches no live User but a soft-deleted one.
 func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
 	nodes, err := uq.Limit(2).All(ctx)
 	if err != nil {
@@ -116,7 +172,7 @@ func (uq *UserQuery) Only(ctx context.Context) (*User, error) {
 	case 1:
 		return nodes[0], nil
 	case 0:
-		return nil, &NotFoundError{user.Label}
+		return nil, uq.notFound(ctx)
 	default:
 		return nil, &NotSingularError{user.Label}
 	}
@@ -134,6 +190,8 @@ func (uq *UserQuery) OnlyX(ctx context.Context) *User {
 // OnlyID is like Only, but returns the only User ID in the query.
 // Returns a *NotSingularError when more than one User ID is found.
 // Returns a *NotFoundError when no entities are found.
+// Returns a *DeletedError, which unwraps to a *NotFoundError, when the query
+// matches no live User but a soft-deleted one.
 func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
 	var ids []int
 	if ids, err = uq.Limit(2).IDs(ctx); err != nil {
@@ -143,7 +201,7 @@ func (uq *UserQuery) OnlyID(ctx context.Context) (id int, err error) {
 	case 1:
 		id = ids[0]
 	case 0:
-		err = &NotFoundError{user.Label}
+		err = uq.notFound(ctx)
 	default:
 		err = &NotSingularError{user.Label}
 	}
@@ -235,11 +293,13 @@ func (uq *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:     uq.config,
-		limit:      uq.limit,
-		offset:     uq.offset,
-		order:      append([]OrderFunc{}, uq.order...),
-		predicates: append([]predicate.User{}, uq.predicates...),
+		config:       uq.config,
+		limit:        uq.limit,
+		offset:       uq.offset,
+		order:        append([]OrderFunc{}, uq.order...),
+		predicates:   append([]predicate.User{}, uq.predicates...),
+		withTodos:    uq.withTodos.Clone(),
+		withSessions: uq.withSessions.Clone(),
 		// clone intermediate query.
 		sql:    uq.sql.Clone(),
 		path:   uq.path,
@@ -247,6 +307,28 @@ func (uq *UserQuery) Clone() *UserQuery {
 	}
 }
 
+// WithTodos tells the query-builder to eager-load the nodes that are connected to
+// the "todos" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithTodos(opts ...func(*TodoQuery)) *UserQuery {
+	query := &TodoQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withTodos = query
+	return uq
+}
+
+// WithSessions tells the query-builder to eager-load the nodes that are connected to
+// the "sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithSessions(opts ...func(*SessionQuery)) *UserQuery {
+	query := &SessionQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withSessions = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -303,6 +385,9 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
 		}
 	}
+	if err := uq.authorizeDeletedTime(ctx); err != nil {
+		return err
+	}
 	if uq.path != nil {
 		prev, err := uq.path(ctx)
 		if err != nil {
@@ -315,8 +400,12 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 
 func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, error) {
 	var (
-		nodes = []*User{}
-		_spec = uq.querySpec()
+		nodes       = []*User{}
+		_spec       = uq.querySpec()
+		loadedTypes = [2]bool{
+			uq.withTodos != nil,
+			uq.withSessions != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		return (*User).scanValues(nil, columns)
@@ -324,8 +413,10 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	_spec.Assign = func(columns []string, values []interface{}) error {
 		node := &User{config: uq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	uq.deletedTimeSpec(ctx, _spec)
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -335,11 +426,68 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+
+	if query := uq.withTodos; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Todos = []*Todo{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Todo(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.TodosColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.OwnerID
+			node, ok := nodeids[fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+			}
+			node.Edges.Todos = append(node.Edges.Todos, n)
+		}
+	}
+
+	if query := uq.withSessions; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Sessions = []*Session{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Session(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.SessionsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_sessions
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_sessions" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_sessions" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Sessions = append(node.Edges.Sessions, n)
+		}
+	}
+
 	return nodes, nil
 }
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
+	uq.deletedTimeSpec(ctx, _spec)
 	_spec.Node.Columns = uq.fields
 	if len(uq.fields) > 0 {
 		_spec.Unique = uq.unique != nil && *uq.unique
@@ -418,6 +566,9 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if uq.unique != nil && *uq.unique {
 		selector.Distinct()
 	}
+	// Edge predicates read the soft-delete filter from the selector context.
+	selector.WithContext(ctx)
+	uq.filterDeletedTime(ctx, selector)
 	for _, p := range uq.predicates {
 		p(selector)
 	}
@@ -435,6 +586,79 @@ func (uq *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// deletedTimeSpec wraps the predicate of the query spec with the soft-delete filter.
+func (uq *UserQuery) deletedTimeSpec(ctx context.Context, _spec *sqlgraph.QuerySpec) {
+	ps := _spec.Predicate
+	_spec.Predicate = func(selector *sql.Selector) {
+		if ps != nil {
+			ps(selector)
+		}
+		uq.filterDeletedTime(ctx, selector)
+	}
+}
+
+// withDeletedTimeFilter sets the DeletedTimeFilter of the User rows of the query,
+// in place of the one stored in the context.
+func (uq *UserQuery) withDeletedTimeFilter(f DeletedTimeFilter) *UserQuery {
+	uq.deletedTimeFilter = &f
+	return uq
+}
+
+// deletedTimeFilterOf returns the DeletedTimeFilter of the User rows of the query.
+func (uq *UserQuery) deletedTimeFilterOf(ctx context.Context) DeletedTimeFilter {
+	if uq.deletedTimeFilter != nil {
+		return *uq.deletedTimeFilter
+	}
+	return DeletedTimeFilterFromContext(ctx)
+}
+
+// filterDeletedTime applies the DeletedTimeFilter of the query on the given selector.
+func (uq *UserQuery) filterDeletedTime(ctx context.Context, selector *sql.Selector) {
+	switch uq.deletedTimeFilterOf(ctx) {
+	case DeletedTimeInclude:
+	case DeletedTimeOnly:
+		userDeleted()(selector)
+	default:
+		userNotDeleted()(selector)
+	}
+}
+
+// notFound returns the error of an Only query that matched no User. It is a
+// DeletedError if the query matches a soft-deleted User once the
+// soft-delete filter is lifted, and a NotFoundError otherwise.
+func (uq *UserQuery) notFound(ctx context.Context) error {
+	if uq.deletedTimeFilterOf(ctx) != DeletedTimeExclude {
+		return &NotFoundError{user.Label}
+	}
+	ids, err := uq.Clone().withDeletedTimeFilter(DeletedTimeInclude).Where(userDeleted()).Limit(1).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return &NotFoundError{user.Label}
+	}
+	if err := NewUserClient(uq.config).DeletedError(ctx, ids[0]); err != nil {
+		return err
+	}
+	return &NotFoundError{user.Label}
+}
+
+// authorizeDeletedTime authorizes the query of soft-deleted User rows and neighbors
+// matched by the edge predicates of the query, and returns a BypassDeniedError
+// if the soft-delete policy denies it.
+func (uq *UserQuery) authorizeDeletedTime(ctx context.Context) error {
+	var types []string
+	// The filter set on the query by the generated code is not authorized.
+	if uq.deletedTimeFilter == nil {
+		types = append(types, TypeUser)
+	}
+	return uq.authorizeDeletedTimeFilter(ctx, user.Table, func(s *sql.Selector) {
+		for _, p := range uq.predicates {
+			p(s)
+		}
+	}, types...)
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	config